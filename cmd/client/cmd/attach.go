@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	todov1connect "github.com/kogamitora/todo/gen/proto/todo/v1/v1connect"
+)
+
+// uploadChunkSize is the size of the chunks sent by 'attach'.
+const uploadChunkSize = 64 * 1024
+
+var downloadOutput string
+
+var attachCmd = &cobra.Command{
+	Use:   "attach [ID] [FILE]",
+	Short: "Attach a file to a TODO item",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseID(args[0])
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Open(args[1])
+		if err != nil {
+			return fmt.Errorf("Failed to open file: %w", err)
+		}
+		defer f.Close()
+
+		client := todov1connect.NewAttachmentServiceClient(
+			httpClient,
+			ServerURL,
+			clientOptions...,
+		)
+
+		stream := client.UploadAttachment(cmd.Context())
+		err = stream.Send(&todov1.UploadAttachmentRequest{
+			Payload: &todov1.UploadAttachmentRequest_Info{Info: &todov1.UploadAttachmentInfo{
+				TodoId:      id,
+				Filename:    filepath.Base(args[1]),
+				ContentType: mime.TypeByExtension(filepath.Ext(args[1])),
+			}},
+		})
+
+		buf := make([]byte, uploadChunkSize)
+		for err == nil {
+			n, readErr := f.Read(buf)
+			if n > 0 {
+				err = stream.Send(&todov1.UploadAttachmentRequest{
+					Payload: &todov1.UploadAttachmentRequest_Chunk{Chunk: buf[:n]},
+				})
+			}
+			if errors.Is(readErr, io.EOF) {
+				break
+			}
+			if readErr != nil {
+				return fmt.Errorf("Failed to read file: %w", readErr)
+			}
+		}
+		// a send error is reported by CloseAndReceive with the server's reason
+		res, err := stream.CloseAndReceive()
+		if err != nil {
+			return newRPCError("Failed to upload attachment", err)
+		}
+
+		a := res.Msg.Attachment
+		fmt.Fprintf(cmd.OutOrStdout(), "Successfully attached %s (%d bytes) with ID: %d\n", a.Filename, a.Size, a.Id)
+		return nil
+	},
+}
+
+var downloadCmd = &cobra.Command{
+	Use:   "download [ATTACHMENT_ID]",
+	Short: "Download an attachment",
+	Long:  "Download an attachment into the current directory, or to the path given with --output.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseID(args[0])
+		if err != nil {
+			return err
+		}
+
+		client := todov1connect.NewAttachmentServiceClient(
+			httpClient,
+			ServerURL,
+			clientOptions...,
+		)
+
+		stream, err := client.DownloadAttachment(cmd.Context(), connect.NewRequest(&todov1.DownloadAttachmentRequest{Id: id}))
+		if err != nil {
+			return newRPCError("Failed to download attachment", err)
+		}
+		defer stream.Close()
+
+		if !stream.Receive() {
+			return newRPCError("Failed to download attachment", stream.Err())
+		}
+		a := stream.Msg().GetAttachment()
+		if a == nil {
+			return errors.New("Failed to download attachment: missing attachment info")
+		}
+
+		output := downloadOutput
+		if output == "" {
+			output = a.Filename
+		}
+		f, err := os.Create(output)
+		if err != nil {
+			return fmt.Errorf("Failed to create file: %w", err)
+		}
+		defer f.Close()
+
+		for stream.Receive() {
+			if _, err := f.Write(stream.Msg().GetChunk()); err != nil {
+				return fmt.Errorf("Failed to write file: %w", err)
+			}
+		}
+		if err := stream.Err(); err != nil {
+			return newRPCError("Failed to download attachment", err)
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Successfully downloaded %s (%d bytes) to %s\n", a.Filename, a.Size, output)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(attachCmd)
+	rootCmd.AddCommand(downloadCmd)
+	downloadCmd.Flags().StringVarP(&downloadOutput, "output", "o", "", "Path to write the attachment to (default: its filename)")
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/kogamitora/todo/internal/testserver"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// cli runs todocli commands against an in-process server and records a
+// transcript of the commands and their output.
+type cli struct {
+	t          *testing.T
+	srv        *testserver.Server
+	transcript strings.Builder
+	// hidden are the pairs of values that change between runs, such as
+	// paths, and their placeholders.
+	hidden []string
+}
+
+func newCLI(t *testing.T) *cli {
+	t.Setenv("TRACING_EXPORTER", "")
+	return &cli{t: t, srv: testserver.NewMemory(t)}
+}
+
+// run executes todocli with args, feeding it stdin, and returns what it
+// printed to stdout and the error it failed with.
+func (c *cli) run(stdin string, args ...string) (string, error) {
+	c.t.Helper()
+	resetFlags(rootCmd)
+
+	var stdout, stderr bytes.Buffer
+	rootCmd.SetArgs(append([]string{"--server", c.srv.URL}, args...))
+	rootCmd.SetIn(strings.NewReader(stdin))
+	rootCmd.SetOut(&stdout)
+	rootCmd.SetErr(&stderr)
+	err := rootCmd.ExecuteContext(context.Background())
+	if err != nil {
+		// like Execute
+		stopTracing(context.Background())
+	}
+
+	c.transcript.WriteString("$ todocli")
+	for _, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " ,\"") {
+			arg = strconv.Quote(arg)
+		}
+		c.transcript.WriteString(" " + arg)
+	}
+	c.transcript.WriteString("\n")
+	c.transcript.WriteString(stdout.String())
+	if err != nil {
+		c.transcript.WriteString("error: " + err.Error() + "\n")
+	}
+	c.transcript.WriteString("\n")
+	return stdout.String(), err
+}
+
+// mustRun is run for commands that must succeed.
+func (c *cli) mustRun(args ...string) string {
+	c.t.Helper()
+	out, err := c.run("", args...)
+	if err != nil {
+		c.t.Fatalf("todocli %s: %v", strings.Join(args, " "), err)
+	}
+	return out
+}
+
+// hide replaces s with placeholder in the transcript.
+func (c *cli) hide(s, placeholder string) {
+	c.hidden = append(c.hidden, s, placeholder)
+}
+
+var (
+	timestamps = regexp.MustCompile(`\d{4}-\d{2}-\d{2} \d{2}:\d{2}`)
+	requestIDs = regexp.MustCompile(`request ID: \w+`)
+)
+
+// checkGolden compares the transcript with testdata/<test name>.golden, or
+// rewrites the file with -update. Times of creation and request IDs are
+// replaced with placeholders first.
+func (c *cli) checkGolden() {
+	c.t.Helper()
+	got := strings.NewReplacer(c.hidden...).Replace(c.transcript.String())
+	got = timestamps.ReplaceAllString(got, "<time>")
+	got = requestIDs.ReplaceAllString(got, "request ID: <id>")
+
+	path := filepath.Join("testdata", c.t.Name()+".golden")
+	if *update {
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			c.t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		c.t.Fatalf("%v (run the tests with -update to create it)", err)
+	}
+	if got != string(want) {
+		c.t.Errorf("output differs from %s (run the tests with -update to accept it)\ngot:\n%s\nwant:\n%s", path, got, want)
+	}
+}
+
+// resetFlags puts the flags of cmd and its subcommands back to their
+// defaults, since they are package variables shared by every run.
+func resetFlags(cmd *cobra.Command) {
+	reset := func(f *pflag.Flag) {
+		if f.Changed {
+			f.Value.Set(f.DefValue)
+			f.Changed = false
+		}
+	}
+	cmd.Flags().VisitAll(reset)
+	cmd.PersistentFlags().VisitAll(reset)
+	for _, sub := range cmd.Commands() {
+		resetFlags(sub)
+	}
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTodoLifecycle(t *testing.T) {
+	c := newCLI(t)
+	c.mustRun("create", "--title", "write tests", "--due-date", "2030-01-02")
+	c.mustRun("create", "--title", "review", "--description", "the harness")
+	c.mustRun("create", "--title", "ship", "--due-date", "2030-01-01")
+	c.mustRun("get", "--sort-by-due", "asc")
+	c.mustRun("update", "1", "--status", "in_progress", "--title", "write more tests")
+	c.mustRun("get", "--status", "in_progress")
+	c.mustRun("show", "2")
+	c.run("n\n", "delete", "2")
+	c.run("y\n", "delete", "2")
+	c.mustRun("get")
+	c.checkGolden()
+}
+
+func TestComments(t *testing.T) {
+	c := newCLI(t)
+	// the server records the local user, who sends the requests, as the author
+	if user := defaultAuthor(); user != "" {
+		c.hide(user, "<user>")
+	}
+	c.mustRun("create", "--title", "discuss")
+	c.mustRun("comment", "1", "first")
+	c.mustRun("comment", "1", "second")
+	c.mustRun("comment", "edit", "1", "first, edited")
+	c.mustRun("comment", "delete", "2")
+	c.mustRun("comment", "list", "1")
+	c.mustRun("show", "1", "--comments", "1")
+	c.checkGolden()
+}
+
+func TestDependencies(t *testing.T) {
+	c := newCLI(t)
+	for _, title := range []string{"release", "build", "test"} {
+		c.mustRun("create", "--title", title)
+	}
+	c.mustRun("deps", "add", "1", "2")
+	c.mustRun("deps", "add", "2", "3")
+	c.mustRun("deps", "add", "1", "3")
+	c.mustRun("deps", "1")
+	c.mustRun("get", "--deps", "blocked")
+	c.run("", "update", "1", "--status", "completed")
+	c.run("", "deps", "add", "3", "1")
+	c.mustRun("deps", "remove", "1", "3")
+	c.mustRun("move", "3", "--before", "1")
+	c.mustRun("get", "--sort", "manual")
+	c.checkGolden()
+}
+
+func TestAttachments(t *testing.T) {
+	c := newCLI(t)
+	dir := t.TempDir()
+	c.hide(dir, "<dir>")
+	file := filepath.Join(dir, "notes.txt")
+	if err := os.WriteFile(file, []byte("remember the milk\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	c.mustRun("create", "--title", "shopping")
+	c.mustRun("attach", "1", file)
+	c.mustRun("show", "1", "--comments", "0")
+	downloaded := filepath.Join(dir, "copy.txt")
+	c.mustRun("download", "1", "--output", downloaded)
+	c.checkGolden()
+
+	got, err := os.ReadFile(downloaded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "remember the milk\n" {
+		t.Errorf("downloaded %q", got)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	c := newCLI(t)
+	for _, args := range [][]string{
+		{"show", "1"},
+		{"show", "abc"},
+		{"create", "--title", ""},
+		{"create", "--title", "a", "--due-date", "tomorrow"},
+		{"get", "--status", "done"},
+		{"move", "1"},
+		{"comment", "1", "hi"},
+	} {
+		if _, err := c.run("", args...); err == nil {
+			t.Errorf("todocli %s succeeded", strings.Join(args, " "))
+		}
+	}
+	c.checkGolden()
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/user"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	todov1connect "github.com/kogamitora/todo/gen/proto/todo/v1/v1connect"
+)
+
+var commentCmd = &cobra.Command{
+	Use:   "comment [ID] [TEXT]",
+	Short: "Add a comment to a TODO item",
+	Long:  "Add a comment to a TODO item. Use the subcommands to list, edit or delete comments.",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseID(args[0])
+		if err != nil {
+			return err
+		}
+
+		client := todov1connect.NewCommentServiceClient(
+			httpClient,
+			ServerURL,
+			clientOptions...,
+		)
+
+		req := &todov1.AddCommentRequest{
+			TodoId: id,
+			Body:   args[1],
+		}
+		res, err := client.AddComment(cmd.Context(), connect.NewRequest(req))
+		if err != nil {
+			return newRPCError("Failed to add comment", err)
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Successfully added comment with ID: %d\n", res.Msg.Comment.Id)
+		return nil
+	},
+}
+
+var commentListCmd = &cobra.Command{
+	Use:   "list [ID]",
+	Short: "List the comments of a TODO item",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseID(args[0])
+		if err != nil {
+			return err
+		}
+
+		client := todov1connect.NewCommentServiceClient(
+			httpClient,
+			ServerURL,
+			clientOptions...,
+		)
+
+		res, err := client.ListComments(cmd.Context(), connect.NewRequest(&todov1.ListCommentsRequest{TodoId: id}))
+		if err != nil {
+			return newRPCError("Failed to list comments", err)
+		}
+
+		printComments(cmd.OutOrStdout(), res.Msg.Comments)
+		return nil
+	},
+}
+
+var commentEditCmd = &cobra.Command{
+	Use:   "edit [COMMENT_ID] [TEXT]",
+	Short: "Edit a comment",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseID(args[0])
+		if err != nil {
+			return err
+		}
+
+		client := todov1connect.NewCommentServiceClient(
+			httpClient,
+			ServerURL,
+			clientOptions...,
+		)
+
+		req := &todov1.UpdateCommentRequest{
+			Id:   id,
+			Body: args[1],
+		}
+		_, err = client.UpdateComment(cmd.Context(), connect.NewRequest(req))
+		if err != nil {
+			return newRPCError("Failed to edit comment", err)
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Successfully edited comment with ID: %d\n", id)
+		return nil
+	},
+}
+
+var commentDeleteCmd = &cobra.Command{
+	Use:   "delete [COMMENT_ID]",
+	Short: "Delete a comment",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseID(args[0])
+		if err != nil {
+			return err
+		}
+
+		client := todov1connect.NewCommentServiceClient(
+			httpClient,
+			ServerURL,
+			clientOptions...,
+		)
+
+		_, err = client.DeleteComment(cmd.Context(), connect.NewRequest(&todov1.DeleteCommentRequest{Id: id}))
+		if err != nil {
+			return newRPCError("Failed to delete comment", err)
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Successfully deleted comment with ID: %d\n", id)
+		return nil
+	},
+}
+
+func printComments(w io.Writer, comments []*todov1.Comment) {
+	if len(comments) == 0 {
+		fmt.Fprintln(w, "No comments.")
+		return
+	}
+	for _, c := range comments {
+		fmt.Fprintf(w, "#%d %s (%s)\n", c.Id, c.Author, c.CreatedAt.AsTime().Local().Format("2006-01-02 15:04"))
+		fmt.Fprintf(w, "  %s\n", c.Body)
+	}
+}
+
+// defaultAuthor is the local user name, sent as the X-User of every
+// request. The server records it as the author of comments.
+func defaultAuthor() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
+
+func init() {
+	rootCmd.AddCommand(commentCmd)
+	commentCmd.AddCommand(commentListCmd)
+	commentCmd.AddCommand(commentEditCmd)
+	commentCmd.AddCommand(commentDeleteCmd)
+}
@@ -1,10 +1,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"log"
-	"net/http"
 	"time"
 
 	"connectrpc.com/connect"
@@ -24,10 +21,11 @@ var (
 var createCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new TODO item",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		client := todov1connect.NewTodoServiceClient(
-			http.DefaultClient,
+			httpClient,
 			ServerURL,
+			clientOptions...,
 		)
 		req := &todov1.CreateTodoRequest{
 			Title:       title,
@@ -36,15 +34,16 @@ var createCmd = &cobra.Command{
 		if dueDate != "" {
 			t, err := time.Parse("2006-01-02", dueDate) //参考タイムパッケージのフォーマット
 			if err != nil {
-				log.Fatalf("Invalid due date format. Use YYYY-MM-DD: %v", err)
+				return fmt.Errorf("Invalid due date format. Use YYYY-MM-DD: %w", err)
 			}
 			req.DueDate = timestamppb.New(t)
 		}
-		res, err := client.CreateTodo(context.Background(), connect.NewRequest(req))
+		res, err := client.CreateTodo(cmd.Context(), connect.NewRequest(req))
 		if err != nil {
-			log.Fatalf("Failed to create todo: %v", err)
+			return newRPCError("Failed to create todo", err)
 		}
-		fmt.Printf("Successfully created TODO item with ID: %d\n", res.Msg.Todo.Id)
+		fmt.Fprintf(cmd.OutOrStdout(), "Successfully created TODO item with ID: %d\n", res.Msg.Todo.Id)
+		return nil
 	},
 }
 
@@ -2,11 +2,7 @@ package cmd
 
 import (
 	"bufio"
-	"context"
 	"fmt"
-	"log"
-	"net/http"
-	"os"
 	"strconv"
 	"strings"
 
@@ -22,40 +18,42 @@ var deleteCmd = &cobra.Command{
 	Short: "Delete a TODO item",
 	Long:  "Logically delete a TODO item by its ID. The item will not be listed anymore but remains in the database.",
 	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		id, err := strconv.ParseInt(args[0], 10, 64)
 		if err != nil {
-			log.Fatalf("Invalid ID provided: %v", err)
+			return fmt.Errorf("Invalid ID provided: %w", err)
 		}
 
 		// --- 確認 ---
-		fmt.Printf("Are you sure you want to delete TODO item with ID %d? (y/N): ", id)
+		fmt.Fprintf(cmd.OutOrStdout(), "Are you sure you want to delete TODO item with ID %d? (y/N): ", id)
 
-		reader := bufio.NewReader(os.Stdin)
+		reader := bufio.NewReader(cmd.InOrStdin())
 		input, _ := reader.ReadString('\n')
 		input = strings.ToLower(strings.TrimSpace(input))
 
 		if input != "y" && input != "yes" {
-			fmt.Println("Deletion cancelled.")
-			return
+			fmt.Fprintln(cmd.OutOrStdout(), "Deletion cancelled.")
+			return nil
 		}
 		// --- 確認完了 ---
 
 		client := todov1connect.NewTodoServiceClient(
-			http.DefaultClient,
+			httpClient,
 			ServerURL,
+			clientOptions...,
 		)
 
 		req := &todov1.DeleteTodoRequest{
 			Id: id,
 		}
 
-		_, err = client.DeleteTodo(context.Background(), connect.NewRequest(req))
+		_, err = client.DeleteTodo(cmd.Context(), connect.NewRequest(req))
 		if err != nil {
-			log.Fatalf("Failed to delete todo: %v", err)
+			return newRPCError("Failed to delete todo", err)
 		}
 
-		fmt.Printf("Successfully deleted TODO item with ID: %d\n", id)
+		fmt.Fprintf(cmd.OutOrStdout(), "Successfully deleted TODO item with ID: %d\n", id)
+		return nil
 	},
 }
 
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	todov1connect "github.com/kogamitora/todo/gen/proto/todo/v1/v1connect"
+)
+
+var depsCmd = &cobra.Command{
+	Use:   "deps [ID]",
+	Short: "Show the dependency graph of a TODO item",
+	Long:  "Print the TODO item and, recursively, every TODO item that blocks it.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseID(args[0])
+		if err != nil {
+			return err
+		}
+
+		client := todov1connect.NewTodoServiceClient(
+			httpClient,
+			ServerURL,
+			clientOptions...,
+		)
+
+		return printDependencyTree(cmd.Context(), cmd.OutOrStdout(), client, id, "", "", map[int64]bool{})
+	},
+}
+
+var depsAddCmd = &cobra.Command{
+	Use:   "add [ID] [BLOCKER_ID]",
+	Short: "Mark a TODO item as blocked by another",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseID(args[0])
+		if err != nil {
+			return err
+		}
+		blockerID, err := parseID(args[1])
+		if err != nil {
+			return err
+		}
+
+		client := todov1connect.NewTodoServiceClient(
+			httpClient,
+			ServerURL,
+			clientOptions...,
+		)
+
+		req := &todov1.AddDependencyRequest{
+			TodoId:      id,
+			BlockedById: blockerID,
+		}
+		_, err = client.AddDependency(cmd.Context(), connect.NewRequest(req))
+		if err != nil {
+			return newRPCError("Failed to add dependency", err)
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "TODO item %d is now blocked by %d\n", id, blockerID)
+		return nil
+	},
+}
+
+var depsRemoveCmd = &cobra.Command{
+	Use:   "remove [ID] [BLOCKER_ID]",
+	Short: "Remove a blocking relationship between two TODO items",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseID(args[0])
+		if err != nil {
+			return err
+		}
+		blockerID, err := parseID(args[1])
+		if err != nil {
+			return err
+		}
+
+		client := todov1connect.NewTodoServiceClient(
+			httpClient,
+			ServerURL,
+			clientOptions...,
+		)
+
+		req := &todov1.RemoveDependencyRequest{
+			TodoId:      id,
+			BlockedById: blockerID,
+		}
+		_, err = client.RemoveDependency(cmd.Context(), connect.NewRequest(req))
+		if err != nil {
+			return newRPCError("Failed to remove dependency", err)
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "TODO item %d is no longer blocked by %d\n", id, blockerID)
+		return nil
+	},
+}
+
+// printDependencyTree prints id and its blockers as an indented tree.
+// Items already printed elsewhere in the tree are marked instead of expanded.
+func printDependencyTree(ctx context.Context, w io.Writer, client todov1connect.TodoServiceClient, id int64, prefix, childPrefix string, seen map[int64]bool) error {
+	res, err := client.GetTodo(ctx, connect.NewRequest(&todov1.GetTodoRequest{Id: id}))
+	if err != nil {
+		return newRPCError(fmt.Sprintf("Failed to get todo %d", id), err)
+	}
+	todo := res.Msg.Todo
+
+	statusStr := strings.Replace(todo.Status.String(), "STATUS_", "", 1)
+	if seen[id] {
+		fmt.Fprintf(w, "%s%d [%s] %s (see above)\n", prefix, todo.Id, statusStr, todo.Title)
+		return nil
+	}
+	seen[id] = true
+	fmt.Fprintf(w, "%s%d [%s] %s\n", prefix, todo.Id, statusStr, todo.Title)
+
+	for i, blockerID := range todo.BlockedBy {
+		var err error
+		if i == len(todo.BlockedBy)-1 {
+			err = printDependencyTree(ctx, w, client, blockerID, childPrefix+"└── ", childPrefix+"    ", seen)
+		} else {
+			err = printDependencyTree(ctx, w, client, blockerID, childPrefix+"├── ", childPrefix+"│   ", seen)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseID(s string) (int64, error) {
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid ID provided: %w", err)
+	}
+	return id, nil
+}
+
+func init() {
+	rootCmd.AddCommand(depsCmd)
+	depsCmd.AddCommand(depsAddCmd)
+	depsCmd.AddCommand(depsRemoveCmd)
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	"github.com/kogamitora/todo/internal/logging"
+)
+
+// rpcError is a failed call. Its message lists the field violations and
+// the request ID sent by the server below the action that failed.
+type rpcError struct {
+	action string
+	err    error
+}
+
+func newRPCError(action string, err error) error {
+	return &rpcError{action: action, err: err}
+}
+
+func (e *rpcError) Error() string {
+	var b strings.Builder
+	printRPCError(&b, e.action, e.err)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (e *rpcError) Unwrap() error {
+	return e.err
+}
+
+func printRPCError(w io.Writer, action string, err error) {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		fmt.Fprintf(w, "%s: %v\n", action, err)
+		return
+	}
+	fmt.Fprintf(w, "%s: %s (%s)\n", action, connectErr.Message(), strings.ReplaceAll(connectErr.Code().String(), "_", " "))
+
+	for _, detail := range connectErr.Details() {
+		value, err := detail.Value()
+		if err != nil {
+			continue
+		}
+		switch d := value.(type) {
+		case *errdetails.BadRequest:
+			for _, v := range d.FieldViolations {
+				fmt.Fprintf(w, "  - %s: %s\n", v.Field, v.Description)
+			}
+		case *errdetails.QuotaFailure:
+			for _, v := range d.Violations {
+				fmt.Fprintf(w, "  - %s: %s\n", v.Subject, v.Description)
+			}
+		case *errdetails.RetryInfo:
+			fmt.Fprintf(w, "  retry after: %s\n", d.RetryDelay.AsDuration().Round(time.Millisecond))
+		case *errdetails.ErrorInfo:
+			fmt.Fprintf(w, "  reason: %s\n", d.Reason)
+		}
+	}
+	if id := connectErr.Meta().Get(logging.RequestIDHeader); id != "" {
+		fmt.Fprintf(w, "  request ID: %s\n", id)
+	}
+}
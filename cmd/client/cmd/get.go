@@ -1,10 +1,8 @@
 package cmd
 
 import (
-	"context"
+	"errors"
 	"fmt"
-	"log"
-	"net/http"
 	"strings"
 
 	"connectrpc.com/connect"
@@ -16,30 +14,28 @@ import (
 
 // filter and sort flags
 var (
-	statusFilter  string
-	sortByDueDate string
+	statusFilter     string
+	sortByDueDate    string
+	dependencyFilter string
+	sortMode         string
 )
 
 var getCmd = &cobra.Command{
 	Use:   "get",
 	Short: "Get all TODO items",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		client := todov1connect.NewTodoServiceClient(
-			http.DefaultClient,
+			httpClient,
 			ServerURL,
+			clientOptions...,
 		)
 		req := &todov1.GetTodosRequest{}
 
 		// filter by status
 		if statusFilter != "" {
-			var status todov1.Status
-			switch statusFilter {
-			case "completed":
-				status = todov1.Status_STATUS_COMPLETED
-			case "incomplete":
-				status = todov1.Status_STATUS_INCOMPLETE
-			default:
-				log.Fatalf("Invalid status filter. Use 'completed' or 'incomplete'")
+			status, err := parseStatus(statusFilter)
+			if err != nil {
+				return fmt.Errorf("Invalid status filter: %w", err)
 			}
 			req.StatusFilter = &status
 		}
@@ -53,18 +49,43 @@ var getCmd = &cobra.Command{
 			case "desc":
 				sortOrder = todov1.SortOrder_SORT_ORDER_DESC
 			default:
-				log.Fatalf("Invalid sort order. Use 'asc' or 'desc'.")
+				return errors.New("Invalid sort order. Use 'asc' or 'desc'.")
 			}
 			req.SortByDueDate = &sortOrder
 		}
 
-		res, err := client.GetTodos(context.Background(), connect.NewRequest(req))
+		// manual order set with 'move'
+		if sortMode != "" {
+			switch strings.ToLower(sortMode) {
+			case "manual":
+				sort := todov1.Sort_SORT_MANUAL
+				req.Sort = &sort
+			default:
+				return errors.New("Invalid sort mode. Use 'manual'.")
+			}
+		}
+
+		// filter by open blockers
+		if dependencyFilter != "" {
+			var filter todov1.DependencyFilter
+			switch strings.ToLower(dependencyFilter) {
+			case "ready":
+				filter = todov1.DependencyFilter_DEPENDENCY_FILTER_READY
+			case "blocked":
+				filter = todov1.DependencyFilter_DEPENDENCY_FILTER_BLOCKED
+			default:
+				return errors.New("Invalid dependency filter. Use 'ready' or 'blocked'.")
+			}
+			req.DependencyFilter = &filter
+		}
+
+		res, err := client.GetTodos(cmd.Context(), connect.NewRequest(req))
 		if err != nil {
-			log.Fatalf("Failed to get todos: %v", err)
+			return newRPCError("Failed to get todos", err)
 		}
 
-		fmt.Println("ID\tStatus\t\tDue Date\tTitle")
-		fmt.Println("----------------------------------------------------------")
+		fmt.Fprintln(cmd.OutOrStdout(), "ID\tStatus\t\tDue Date\tTitle")
+		fmt.Fprintln(cmd.OutOrStdout(), "----------------------------------------------------------")
 		for _, todo := range res.Msg.Todos {
 			dueDateStr := "N/A"
 			if todo.DueDate != nil && todo.DueDate.IsValid() {
@@ -73,13 +94,16 @@ var getCmd = &cobra.Command{
 			// Convert status to string without the prefix
 			// e.g., STATUS_COMPLETED -> COMPLETED
 			statusStr := strings.Replace(todo.Status.String(), "STATUS_", "", 1)
-			fmt.Printf("%d\t%-10s\t%s\t%s\n", todo.Id, statusStr, dueDateStr, todo.Title)
+			fmt.Fprintf(cmd.OutOrStdout(), "%d\t%-11s\t%s\t%s\n", todo.Id, statusStr, dueDateStr, todo.Title)
 		}
+		return nil
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(getCmd)
-	getCmd.Flags().StringVar(&statusFilter, "status", "", "Filter by status (completed|incomplete)")
+	getCmd.Flags().StringVar(&statusFilter, "status", "", "Filter by status ("+statusUsage+")")
 	getCmd.Flags().StringVar(&sortByDueDate, "sort-by-due", "", "Sort by due date (asc|desc)")
+	getCmd.Flags().StringVar(&sortMode, "sort", "", "Sort mode (manual)")
+	getCmd.Flags().StringVar(&dependencyFilter, "deps", "", "Filter by open blockers (ready|blocked)")
 }
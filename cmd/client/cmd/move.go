@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	todov1connect "github.com/kogamitora/todo/gen/proto/todo/v1/v1connect"
+)
+
+var (
+	moveBefore int64
+	moveAfter  int64
+)
+
+var moveCmd = &cobra.Command{
+	Use:   "move [ID]",
+	Short: "Reorder a TODO item",
+	Long:  "Move a TODO item directly before or after another item in the same status column. Use 'get --sort manual' to see the order.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseID(args[0])
+		if err != nil {
+			return err
+		}
+
+		req := &todov1.MoveTodoRequest{
+			Id: id,
+		}
+		if cmd.Flags().Changed("before") {
+			req.BeforeId = &moveBefore
+		}
+		if cmd.Flags().Changed("after") {
+			req.AfterId = &moveAfter
+		}
+		if req.BeforeId == nil && req.AfterId == nil {
+			return errors.New("Either --before or --after is required.")
+		}
+
+		client := todov1connect.NewTodoServiceClient(
+			httpClient,
+			ServerURL,
+			clientOptions...,
+		)
+
+		_, err = client.MoveTodo(cmd.Context(), connect.NewRequest(req))
+		if err != nil {
+			return newRPCError("Failed to move todo", err)
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Successfully moved TODO item with ID: %d\n", id)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(moveCmd)
+	moveCmd.Flags().Int64Var(&moveBefore, "before", 0, "ID of the TODO to place this item before")
+	moveCmd.Flags().Int64Var(&moveAfter, "after", 0, "ID of the TODO to place this item after")
+}
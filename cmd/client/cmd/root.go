@@ -1,23 +1,69 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
+	"strings"
 
+	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
+
+	"github.com/kogamitora/todo/internal/logging"
+	"github.com/kogamitora/todo/internal/tlsconfig"
 )
 
 var (
-	ServerURL string
+	ServerURL  string
+	printTrace bool
+
+	caCert     string
+	clientCert string
+	clientKey  string
+
+	// httpClient and clientOptions are passed to every service client.
+	httpClient    = http.DefaultClient
+	clientOptions []connect.ClientOption
 )
 
 var rootCmd = &cobra.Command{
 	Use:   "todocli",
 	Short: "A CLI client for the TODO gRPC service",
+	// Execute prints the error
+	SilenceErrors: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// the arguments are valid, so a failure is not a usage error
+		cmd.SilenceUsage = true
+		client, err := newHTTPClient()
+		if err != nil {
+			return err
+		}
+		httpClient = client
+
+		ctx, tracing, err := startTracing(cmd.Context(), "todocli "+cmd.Name())
+		if err != nil {
+			return err
+		}
+		cmd.SetContext(ctx)
+		clientOptions = []connect.ClientOption{
+			connect.WithInterceptors(tracing, &userInterceptor{user: defaultAuthor()}),
+		}
+		if printTrace {
+			fmt.Fprintf(cmd.ErrOrStderr(), "trace_id: %s\n", commandSpan.SpanContext().TraceID())
+		}
+		return nil
+	},
+	PersistentPostRunE: func(cmd *cobra.Command, args []string) error {
+		return stopTracing(cmd.Context())
+	},
 }
 
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
+		// PersistentPostRunE is skipped when a command fails
+		stopTracing(context.Background())
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
@@ -34,5 +80,60 @@ func init() {
 		serverPort = "8080"
 	}
 
-	ServerURL = fmt.Sprintf("http://%s:%s", serverHost, serverPort)
+	defaultURL := os.Getenv("SERVER_URL")
+	if defaultURL == "" {
+		defaultURL = fmt.Sprintf("http://%s:%s", serverHost, serverPort)
+	}
+
+	rootCmd.PersistentFlags().StringVar(&ServerURL, "server", defaultURL, "Server URL; use https:// for a server with TLS enabled")
+	rootCmd.PersistentFlags().StringVar(&caCert, "ca-cert", "", "CA certificate to verify the server with (default: system roots)")
+	rootCmd.PersistentFlags().StringVar(&clientCert, "client-cert", "", "Client certificate for servers that require mutual TLS")
+	rootCmd.PersistentFlags().StringVar(&clientKey, "client-key", "", "Private key of --client-cert")
+	rootCmd.PersistentFlags().BoolVar(&printTrace, "trace", false, "Print the trace ID of the command to stderr")
+}
+
+// newHTTPClient returns the default client for http:// URLs and a client
+// with the TLS flags applied for https:// URLs.
+func newHTTPClient() (*http.Client, error) {
+	if !strings.HasPrefix(ServerURL, "https://") {
+		if caCert != "" || clientCert != "" || clientKey != "" {
+			return nil, errors.New("--ca-cert, --client-cert and --client-key require an https:// server URL")
+		}
+		return http.DefaultClient, nil
+	}
+	tlsConfig, err := tlsconfig.NewClient(caCert, clientCert, clientKey)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig:   tlsConfig,
+			ForceAttemptHTTP2: true,
+		},
+	}, nil
+}
+
+// userInterceptor sends the local user name, which the server records in
+// its request logs.
+type userInterceptor struct {
+	user string
+}
+
+func (i *userInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		req.Header().Set(logging.UserHeader, i.user)
+		return next(ctx, req)
+	}
+}
+
+func (i *userInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		conn.RequestHeader().Set(logging.UserHeader, i.user)
+		return conn
+	}
+}
+
+func (i *userInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
 }
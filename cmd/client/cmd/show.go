@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	todov1connect "github.com/kogamitora/todo/gen/proto/todo/v1/v1connect"
+)
+
+var showComments int32
+
+var showCmd = &cobra.Command{
+	Use:   "show [ID]",
+	Short: "Show the details of a TODO item",
+	Long:  "Show all fields of a TODO item together with its latest comments.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseID(args[0])
+		if err != nil {
+			return err
+		}
+
+		todoClient := todov1connect.NewTodoServiceClient(
+			httpClient,
+			ServerURL,
+			clientOptions...,
+		)
+		commentClient := todov1connect.NewCommentServiceClient(
+			httpClient,
+			ServerURL,
+			clientOptions...,
+		)
+
+		res, err := todoClient.GetTodo(cmd.Context(), connect.NewRequest(&todov1.GetTodoRequest{Id: id}))
+		if err != nil {
+			return newRPCError("Failed to get todo", err)
+		}
+		todo := res.Msg.Todo
+
+		dueDateStr := "N/A"
+		if todo.DueDate != nil && todo.DueDate.IsValid() {
+			dueDateStr = todo.DueDate.AsTime().Format("2006-01-02")
+		}
+		blockedBy := "none"
+		if len(todo.BlockedBy) > 0 {
+			ids := make([]string, len(todo.BlockedBy))
+			for i, b := range todo.BlockedBy {
+				ids[i] = fmt.Sprint(b)
+			}
+			blockedBy = strings.Join(ids, ", ")
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "ID:          %d\n", todo.Id)
+		fmt.Fprintf(cmd.OutOrStdout(), "Title:       %s\n", todo.Title)
+		fmt.Fprintf(cmd.OutOrStdout(), "Status:      %s\n", strings.Replace(todo.Status.String(), "STATUS_", "", 1))
+		fmt.Fprintf(cmd.OutOrStdout(), "Due Date:    %s\n", dueDateStr)
+		fmt.Fprintf(cmd.OutOrStdout(), "Blocked By:  %s\n", blockedBy)
+		fmt.Fprintf(cmd.OutOrStdout(), "Created At:  %s\n", todo.CreatedAt.AsTime().Local().Format("2006-01-02 15:04"))
+		fmt.Fprintf(cmd.OutOrStdout(), "Updated At:  %s\n", todo.UpdatedAt.AsTime().Local().Format("2006-01-02 15:04"))
+		if todo.Description != "" {
+			fmt.Fprintf(cmd.OutOrStdout(), "\n%s\n", todo.Description)
+		}
+
+		attachmentClient := todov1connect.NewAttachmentServiceClient(
+			httpClient,
+			ServerURL,
+			clientOptions...,
+		)
+		attachments, err := attachmentClient.ListAttachments(cmd.Context(), connect.NewRequest(&todov1.ListAttachmentsRequest{TodoId: id}))
+		if err != nil {
+			return newRPCError("Failed to list attachments", err)
+		}
+		if len(attachments.Msg.Attachments) > 0 {
+			fmt.Fprintf(cmd.OutOrStdout(), "\nAttachments:\n")
+			for _, a := range attachments.Msg.Attachments {
+				fmt.Fprintf(cmd.OutOrStdout(), "#%d %s (%d bytes)\n", a.Id, a.Filename, a.Size)
+			}
+		}
+
+		if showComments <= 0 {
+			return nil
+		}
+		comments, err := commentClient.ListComments(cmd.Context(), connect.NewRequest(&todov1.ListCommentsRequest{
+			TodoId: id,
+			Limit:  &showComments,
+		}))
+		if err != nil {
+			return newRPCError("Failed to list comments", err)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "\nLatest comments:\n")
+		printComments(cmd.OutOrStdout(), comments.Msg.Comments)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(showCmd)
+	showCmd.Flags().Int32Var(&showComments, "comments", 5, "Number of latest comments to show (0 to hide)")
+}
@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+)
+
+// statusNames maps the CLI status names, the same as in the server's
+// STATUS_TRANSITIONS, to the protobuf enum.
+var statusNames = map[string]todov1.Status{
+	"incomplete":  todov1.Status_STATUS_INCOMPLETE,
+	"in_progress": todov1.Status_STATUS_IN_PROGRESS,
+	"blocked":     todov1.Status_STATUS_BLOCKED,
+	"completed":   todov1.Status_STATUS_COMPLETED,
+	"cancelled":   todov1.Status_STATUS_CANCELLED,
+}
+
+// statusUsage is the flag help listing of accepted status names.
+const statusUsage = "incomplete|in_progress|blocked|completed|cancelled"
+
+func parseStatus(s string) (todov1.Status, error) {
+	status, ok := statusNames[strings.ToLower(s)]
+	if !ok {
+		return todov1.Status_STATUS_UNSPECIFIED, fmt.Errorf("invalid status %q. Use one of %s", s, statusUsage)
+	}
+	return status, nil
+}
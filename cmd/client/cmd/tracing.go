@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"connectrpc.com/connect"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/kogamitora/todo/internal/config"
+	"github.com/kogamitora/todo/internal/telemetry"
+)
+
+var (
+	tracerProvider *sdktrace.TracerProvider
+	commandSpan    trace.Span
+)
+
+// startTracing starts a span for the command, so that all calls it makes
+// share one trace, and returns the interceptor that sends the W3C trace
+// context to the server. Spans are exported only when TRACING_EXPORTER is
+// set, but the trace context is always sent.
+func startTracing(ctx context.Context, name string) (context.Context, connect.Interceptor, error) {
+	cfg := config.TracingConfig{
+		Exporter:     os.Getenv("TRACING_EXPORTER"),
+		OTLPEndpoint: os.Getenv("OTLP_ENDPOINT"),
+		OTLPInsecure: os.Getenv("OTLP_INSECURE") != "false",
+	}
+	if cfg.OTLPEndpoint == "" {
+		cfg.OTLPEndpoint = "localhost:4318"
+	}
+	exporter, err := telemetry.NewExporter(ctx, cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create trace exporter: %w", err)
+	}
+
+	tracerProvider = telemetry.NewTracerProvider(nil, "todocli", 1)
+	if exporter != nil {
+		// export synchronously: the CLI exits right after the command
+		tracerProvider.RegisterSpanProcessor(sdktrace.NewSimpleSpanProcessor(exporter))
+	}
+	telemetry.SetGlobal(tracerProvider)
+
+	interceptor, err := telemetry.NewClientInterceptor()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create tracing interceptor: %w", err)
+	}
+
+	ctx, commandSpan = tracerProvider.Tracer("todocli").Start(ctx, name)
+	return ctx, interceptor, nil
+}
+
+// stopTracing ends the command span and flushes the exporter.
+func stopTracing(ctx context.Context) error {
+	if tracerProvider == nil {
+		return nil
+	}
+	commandSpan.End()
+	return tracerProvider.Shutdown(ctx)
+}
@@ -2,10 +2,7 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
-	"log"
-	"net/http"
 	"strconv"
 	"time"
 
@@ -29,18 +26,19 @@ var updateCmd = &cobra.Command{
 	Short: "Update a TODO item",
 	Long:  "Update a TODO item by its ID. You can update its title, description, due date, or status.",
 	Args:  cobra.ExactArgs(1), // ID is required
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 
 		// 1. ID parsing
 		id, err := strconv.ParseInt(args[0], 10, 64)
 		if err != nil {
-			log.Fatalf("Invalid ID provided: %v", err)
+			return fmt.Errorf("Invalid ID provided: %w", err)
 		}
 
 		// 2. create client
 		client := todov1connect.NewTodoServiceClient(
-			http.DefaultClient,
+			httpClient,
 			ServerURL,
+			clientOptions...,
 		)
 
 		// 3. create request
@@ -58,31 +56,27 @@ var updateCmd = &cobra.Command{
 		if cmd.Flags().Changed("due-date") {
 			t, err := time.Parse("2006-01-02", updateDueDate)
 			if err != nil {
-				log.Fatalf("Invalid due date format. Use YYYY-MM-DD: %v", err)
+				return fmt.Errorf("Invalid due date format. Use YYYY-MM-DD: %w", err)
 			}
 			req.DueDate = timestamppb.New(t)
 		}
 		if cmd.Flags().Changed("status") {
-			var status todov1.Status
-			switch updateStatus {
-			case "completed":
-				status = todov1.Status_STATUS_COMPLETED
-			case "incomplete":
-				status = todov1.Status_STATUS_INCOMPLETE
-			default:
-				log.Fatalf("Invalid status. Use 'completed' or 'incomplete'.")
+			status, err := parseStatus(updateStatus)
+			if err != nil {
+				return fmt.Errorf("Invalid status: %w", err)
 			}
 			req.Status = &status
 		}
 
 		// 5. send request
-		res, err := client.UpdateTodo(context.Background(), connect.NewRequest(req))
+		res, err := client.UpdateTodo(cmd.Context(), connect.NewRequest(req))
 		if err != nil {
-			log.Fatalf("Failed to update todo: %v", err)
+			return newRPCError("Failed to update todo", err)
 		}
 
-		fmt.Printf("Successfully updated TODO item with ID: %d\n", res.Msg.Todo.Id)
-		fmt.Printf("Title: %s\nStatus: %s\n", res.Msg.Todo.Title, res.Msg.Todo.Status)
+		fmt.Fprintf(cmd.OutOrStdout(), "Successfully updated TODO item with ID: %d\n", res.Msg.Todo.Id)
+		fmt.Fprintf(cmd.OutOrStdout(), "Title: %s\nStatus: %s\n", res.Msg.Todo.Title, res.Msg.Todo.Status)
+		return nil
 	},
 }
 
@@ -91,5 +85,5 @@ func init() {
 	updateCmd.Flags().StringVarP(&updateTitle, "title", "t", "", "New title for the TODO")
 	updateCmd.Flags().StringVarP(&updateDescription, "description", "d", "", "New description for the TODO")
 	updateCmd.Flags().StringVar(&updateDueDate, "due-date", "", "New due date in YYYY-MM-DD format")
-	updateCmd.Flags().StringVarP(&updateStatus, "status", "s", "", "New status (completed|incomplete)")
+	updateCmd.Flags().StringVarP(&updateStatus, "status", "s", "", "New status ("+statusUsage+")")
 }
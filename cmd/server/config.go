@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func newConfigCmd() *cobra.Command {
+	configCmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect the server configuration",
+	}
+	configCmd.AddCommand(&cobra.Command{
+		Use:   "print",
+		Short: "Print the effective configuration as YAML, with secrets redacted",
+		Long: `Print the effective configuration as YAML, with secrets redacted.
+
+The output merges the defaults, the config file, the environment and the
+flags exactly as the server would, and can be used as a config file.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cfg, err := loadConfig(cmd.Flags(), slog.New(slog.NewTextHandler(os.Stderr, nil)))
+			if err != nil {
+				return err
+			}
+			if err := cfg.Validate(); err != nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "warning: %v\n", err)
+			}
+			return cfg.WriteYAML(cmd.OutOrStdout())
+		},
+	})
+	return configCmd
+}
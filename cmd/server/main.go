@@ -1,29 +1,82 @@
 package main
 
 import (
+	"context"
+	"database/sql"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
 
-	"golang.org/x/net/http2"
-	"golang.org/x/net/http2/h2c"
+	"connectrpc.com/connect"
+	"connectrpc.com/grpchealth"
+	"connectrpc.com/grpcreflect"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	todov1connect "github.com/kogamitora/todo/gen/proto/todo/v1/v1connect"
+	"github.com/kogamitora/todo/internal/apierr"
 	"github.com/kogamitora/todo/internal/config"
+	"github.com/kogamitora/todo/internal/cors"
 	"github.com/kogamitora/todo/internal/db"
 	"github.com/kogamitora/todo/internal/handler"
+	"github.com/kogamitora/todo/internal/health"
+	"github.com/kogamitora/todo/internal/logging"
+	"github.com/kogamitora/todo/internal/metrics"
+	"github.com/kogamitora/todo/internal/ratelimit"
+	"github.com/kogamitora/todo/internal/repository"
+	"github.com/kogamitora/todo/internal/repository/memory"
+	"github.com/kogamitora/todo/internal/repository/mysql"
+	"github.com/kogamitora/todo/internal/repository/postgres"
+	"github.com/kogamitora/todo/internal/repository/sqlite"
+	"github.com/kogamitora/todo/internal/server"
+	"github.com/kogamitora/todo/internal/service"
+	"github.com/kogamitora/todo/internal/storage"
+	"github.com/kogamitora/todo/internal/telemetry"
+	"github.com/kogamitora/todo/internal/tlsconfig"
+	"github.com/kogamitora/todo/internal/validation"
 )
 
 func main() {
-	// 基本コンポーネントの初期化 (Logger)
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	rootCmd := &cobra.Command{
+		Use:   "server",
+		Short: "Serve the TODO Connect/gRPC API",
+		Long: `Serve the TODO Connect/gRPC API.
+
+Settings are read, in increasing precedence, from the defaults, the config
+file given by --config, the environment (including a .env file in the
+working directory) and the flags below.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			serve(cmd.Flags())
+		},
+	}
+	config.AddFlags(rootCmd.PersistentFlags())
+	rootCmd.AddCommand(newConfigCmd(), newMigrateCmd())
+
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
 
-	// 設定の読み込みと検証
+// loadConfig reads the .env file and then the layered configuration.
+func loadConfig(flags *pflag.FlagSet, logger *slog.Logger) (*config.Config, error) {
 	if err := config.LoadFromFile(".env"); err != nil {
 		logger.Warn("failed to load .env file", "error", err)
 	}
+	return config.Load(flags)
+}
 
-	cfg, err := config.Load()
+func serve(flags *pflag.FlagSet) {
+	// 設定を読み込むまでの Logger
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+
+	// 設定の読み込みと検証 (デフォルト < 設定ファイル < 環境変数 < フラグ)
+	cfg, err := loadConfig(flags, logger)
 	if err != nil {
 		logger.Error("failed to load config", "error", err)
 		os.Exit(1)
@@ -34,40 +87,296 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Logger の初期化 (LOG_LEVEL と LOG_FORMAT に従い、ログにはトレース ID を付与する)
+	// レベルは設定の再読み込みで変更できる
+	level := new(slog.LevelVar)
+	configured, err := logging.New(cfg.Log, level, os.Stdout)
+	if err != nil {
+		logger.Error("failed to create logger", "error", err)
+		os.Exit(1)
+	}
+	logger = configured
+
 	logger.Info("loaded config",
 		"server_host", cfg.Server.Host,
 		"server_port", cfg.Server.Port,
+		"db_driver", cfg.Database.Driver,
 		"db_host", cfg.Database.Host,
 		"db_port", cfg.Database.Port,
 		"db_name", cfg.Database.Database,
 		"db_user", cfg.Database.User,
+		"storage_backend", cfg.Storage.Backend,
+		"grpc_reflection", cfg.Features.Reflection,
+		"metrics", cfg.Features.Metrics,
+		"tracing_exporter", cfg.Tracing.Exporter,
+		"log_level", cfg.Log.Level,
 	)
 
-	// 依存サービスの初期化 (データベース)
-	database, err := db.NewDB(cfg.GetDSN(), logger)
+	// トレーシング (OpenTelemetry、DB 接続より前に設定してクエリのスパンを記録する)
+	exporter, err := telemetry.NewExporter(context.Background(), cfg.Tracing)
+	if err != nil {
+		logger.Error("failed to create trace exporter", "error", err)
+		os.Exit(1)
+	}
+	tracerProvider := telemetry.NewTracerProvider(exporter, "todo-server", cfg.Tracing.SampleRatio)
+	telemetry.SetGlobal(tracerProvider)
+	tracing, err := telemetry.NewServerInterceptor()
+	if err != nil {
+		logger.Error("failed to create tracing interceptor", "error", err)
+		os.Exit(1)
+	}
+
+	// 依存サービスの初期化 (データベース、起動直後に MySQL が未起動でも DB_STARTUP_TIMEOUT まで再試行する)
+	// DB_DRIVER=memory ではデータベースを使わず、データはメモリにだけ保存する (テスト・デモ用)
+	var database *sql.DB
+	var schemaVersion uint
+	if cfg.Database.Driver != config.DriverMemory {
+		database, err = db.NewDB(cfg.GetDSN(), cfg.Database, logger)
+		if err != nil {
+			logger.Error("failed to connect to database", "error", err)
+			os.Exit(1)
+		}
+
+		// スキーマのマイグレーション (バイナリに埋め込んだファイル、または MIGRATIONS_DIR)
+		// AUTO_MIGRATE=true なら起動時に適用する (複数のレプリカはロックで順番に実行する)
+		migrationsFS := db.Migrations(cfg.Database.Driver, cfg.Database.MigrationsDir)
+		schemaVersion, err = db.LatestMigrationVersion(migrationsFS)
+		if err != nil {
+			logger.Error("failed to determine expected schema version", "error", err)
+			os.Exit(1)
+		}
+		if cfg.Database.AutoMigrate {
+			if err := migrateUp(context.Background(), cfg, logger); err != nil {
+				logger.Error("failed to migrate database", "error", err)
+				os.Exit(1)
+			}
+		}
+	}
+
+	blobStore, err := storage.New(cfg.Storage)
+	if err != nil {
+		logger.Error("failed to initialize attachment storage", "error", err)
+		os.Exit(1)
+	}
+
+	transitions, err := service.ParseTransitions(cfg.Workflow.StatusTransitions)
+	if err != nil {
+		logger.Error("invalid STATUS_TRANSITIONS", "error", err)
+		os.Exit(1)
+	}
+
+	validator, err := validation.NewInterceptor()
 	if err != nil {
-		logger.Error("failed to connect to database", "error", err)
+		logger.Error("failed to create validation interceptor", "error", err)
 		os.Exit(1)
 	}
-	defer database.Close()
+
+	chain := []connect.Interceptor{
+		tracing,
+		logging.NewInterceptor(logger),
+	}
+	// 読み取り専用の RPC (GetTodos, GetTodo) はリードレプリカへ振り分ける (DB_REPLICA_DSNS が空なら使わない)
+	// 書き込んだクライアントは DB_REPLICA_STICKY_WINDOW の間プライマリから読み (記録はプロセスごと)、
+	// ヘルスチェックに失敗したレプリカの分もプライマリが引き受ける
+	var executor sqlDB = database
+	var router *db.Router
+	if len(cfg.Database.Replicas.DSNs) > 0 {
+		router, err = newRouter(database, cfg.Database, logger)
+		if err != nil {
+			logger.Error("failed to open database replicas", "error", err)
+			os.Exit(1)
+		}
+		executor = router
+	}
+
+	// Todo の業務ルールはサービス層にあり、保存先はリポジトリ経由で切り替えられる
+	repos := newRepositories(cfg.Database.Driver, executor)
+
+	// メトリクス (RPC ごとのリクエスト数・レイテンシ、コネクションプール、Todo 件数)
+	// 実行中に有効化できるよう常に記録し、/metrics の公開だけを切り替える
+	m := metrics.New(database, cfg.Database.Database, repos.Todos)
+	// クライアントの IP ごとのレート制限 (RATE_LIMIT_RPS=0 で無効化)
+	limiter := ratelimit.New(cfg.Limits.RequestsPerSecond, cfg.Limits.Burst)
+	chain = append(chain,
+		m.Interceptor(),
+		limiter.Interceptor(),
+		// クエリのタイムアウト (クライアントの期限と DB_QUERY_TIMEOUT の短い方)
+		db.NewTimeoutInterceptor(cfg.Database.Timeouts.Query),
+	)
+	chain = append(chain,
+		// 内部エラーの詳細はログにだけ残し、クライアントには返さない
+		apierr.NewInterceptor(logger),
+		// proto に定義した protovalidate のルールで、DB へアクセスする前にリクエストを検証する
+		validator,
+	)
+	if router != nil {
+		// 検証を通ったリクエストだけを書き込みとして記録するため、バリデーターの後に置く
+		// 書き込みを判別するクライアントはレート制限と同じ (IP)
+		chain = append(chain, router.Interceptor(ratelimit.Key,
+			[]string{
+				todov1connect.TodoServiceGetTodosProcedure,
+				todov1connect.TodoServiceGetTodoProcedure,
+			},
+			[]string{
+				todov1connect.TodoServiceCreateTodoProcedure,
+				todov1connect.TodoServiceUpdateTodoProcedure,
+				todov1connect.TodoServiceDeleteTodoProcedure,
+				todov1connect.TodoServiceMoveTodoProcedure,
+				todov1connect.TodoServiceAddDependencyProcedure,
+				todov1connect.TodoServiceRemoveDependencyProcedure,
+				todov1connect.CommentServiceAddCommentProcedure,
+				todov1connect.CommentServiceUpdateCommentProcedure,
+				todov1connect.CommentServiceDeleteCommentProcedure,
+				todov1connect.AttachmentServiceUploadAttachmentProcedure,
+			},
+		))
+	}
+	interceptors := connect.WithInterceptors(chain...)
 
 	// HTTPハンドラとルーティングの設定 (Mux)
-	todoHandler := handler.NewTodoHandler(database, logger)
-	path, h := todov1connect.NewTodoServiceHandler(todoHandler)
+	todoService := service.NewTodoService(repos.Todos, repos.Dependencies, logger, transitions, cfg.Limits.TodosPerUser)
+	todoHandler := handler.NewTodoHandler(todoService)
+	path, h := todov1connect.NewTodoServiceHandler(todoHandler, interceptors)
+	commentPath, commentH := todov1connect.NewCommentServiceHandler(handler.NewCommentHandler(service.NewCommentService(repos.Comments, repos.Todos, logger)), interceptors)
+	attachmentPath, attachmentH := todov1connect.NewAttachmentServiceHandler(
+		handler.NewAttachmentHandler(service.NewAttachmentService(repos.Attachments, repos.Todos, blobStore, cfg.Storage.MaxAttachmentSize, logger)),
+		interceptors,
+	)
+
+	// 相互 TLS ではクライアント証明書を RPC にだけ要求し、ヘルスチェックと
+	// メトリクスは証明書なしで受け付ける
+	requireCert := func(h http.Handler) http.Handler { return h }
+	if cfg.Server.TLS.ClientCAFile != "" {
+		requireCert = tlsconfig.RequireClientCert
+	}
 
 	mux := http.NewServeMux()
-	mux.Handle(path, h)
+	mux.Handle(path, requireCert(h))
+	mux.Handle(commentPath, requireCert(commentH))
+	mux.Handle(attachmentPath, requireCert(attachmentH))
+
+	services := []string{
+		todov1connect.TodoServiceName,
+		todov1connect.CommentServiceName,
+		todov1connect.AttachmentServiceName,
+	}
+
+	// ヘルスチェック (grpc.health.v1 と /healthz, /readyz)
+	checker := health.NewChecker(database, schemaVersion, logger, services...)
+	mux.Handle(grpchealth.NewHandler(checker))
+	mux.Handle("/healthz", health.LivenessHandler())
+	mux.Handle("/readyz", checker.ReadinessHandler())
+	var metricsOn, reflectionOn atomic.Bool
+	metricsOn.Store(cfg.Features.Metrics)
+	reflectionOn.Store(cfg.Features.Reflection)
+	mux.Handle("/metrics", gate(&metricsOn, m.Handler()))
+
+	// gRPC リフレクション (grpcurl や Buf Studio 用、本番では GRPC_REFLECTION=false で無効化)
+	reflector := grpcreflect.NewStaticReflector(append(services, grpchealth.HealthV1ServiceName)...)
+	reflectionPath, reflectionH := grpcreflect.NewHandlerV1(reflector)
+	mux.Handle(reflectionPath, requireCert(gate(&reflectionOn, reflectionH)))
+	reflectionAlphaPath, reflectionAlphaH := grpcreflect.NewHandlerV1Alpha(reflector)
+	mux.Handle(reflectionAlphaPath, requireCert(gate(&reflectionOn, reflectionAlphaH)))
+
+	// ブラウザから別オリジンで呼び出すための CORS (CORS_ALLOWED_ORIGINS が空なら許可しない)
+	corsHandler := cors.New(cfg.Server.CORS.AllowedOrigins)
 
-	// サーバーの起動
+	// サーバーの起動 (SIGINT/SIGTERM で処理中のリクエストを待ってから終了)
 	addr := ":" + cfg.Server.Port
-	server := &http.Server{
-		Addr:    addr,
-		Handler: h2c.NewHandler(mux, &http2.Server{}),
+	srv := server.New(addr, corsHandler.Wrap(mux), cfg.Server.ShutdownTimeout, logger)
+	// TLS_CERT_FILE が設定されていれば TLS で待ち受ける (証明書は更新されると自動で再読み込み)
+	if cfg.Server.TLS.Enabled() {
+		tlsConfig, err := tlsconfig.NewServer(cfg.Server.TLS, logger)
+		if err != nil {
+			logger.Error("failed to configure TLS", "error", err)
+			os.Exit(1)
+		}
+		srv.UseTLS(tlsConfig)
 	}
+	// 残りのスパンを送信してから、データベースを最後に閉じる
+	srv.RegisterCloser("tracing", func() error {
+		return tracerProvider.Shutdown(context.Background())
+	})
+	if router != nil {
+		srv.RegisterCloser("database replicas", router.Close)
+	}
+	if database != nil {
+		srv.RegisterCloser("database", database.Close)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if router != nil {
+		go router.Watch(ctx, cfg.Database.Replicas.HealthInterval)
+	}
+
+	// 設定の再読み込み (設定ファイルの変更または SIGHUP で、ログレベル・レート制限・
+	// CORS・機能フラグだけを反映する。不正な設定は拒否して現在の設定を使い続ける)
+	reloader := &reloader{
+		flags:      flags,
+		logger:     logger,
+		level:      level,
+		limiter:    limiter,
+		cors:       corsHandler,
+		metrics:    &metricsOn,
+		reflection: &reflectionOn,
+		current:    cfg,
+	}
+	if file := config.File(flags); file != "" {
+		if err := config.Watch(ctx, file, reloader.reload); err != nil {
+			logger.Error("failed to watch config file", "error", err)
+			os.Exit(1)
+		}
+	}
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			reloader.reload()
+		}
+	}()
 
-	logger.Info("server starting", "addr", addr, "gRPC_path", path)
-	if err := server.ListenAndServe(); err != nil {
-		logger.Error("server failed", "error", err)
+	logger.Info("server starting", "addr", addr, "gRPC_path", path, "tls", cfg.Server.TLS.Enabled(), "mtls", cfg.Server.TLS.ClientCAFile != "")
+	if err := srv.ListenAndServe(ctx); err != nil {
+		logger.Error("server stopped with error", "error", err)
 		os.Exit(1)
 	}
 }
+
+// newRouter opens the replicas of cfg behind a router over primary. The
+// replicas are not waited for: until they answer, reads go to the primary.
+func newRouter(primary *sql.DB, cfg config.DatabaseConfig, logger *slog.Logger) (*db.Router, error) {
+	var replicas []*sql.DB
+	for i, dsn := range cfg.Replicas.DSNs {
+		replica, err := db.Open(dsn, cfg)
+		if err != nil {
+			for _, r := range replicas {
+				r.Close()
+			}
+			return nil, fmt.Errorf("replica %d: %w", i, err)
+		}
+		replicas = append(replicas, replica)
+	}
+	return db.NewRouter(primary, replicas, cfg.Replicas.StickyWindow, logger), nil
+}
+
+// sqlDB is what the SQL repositories run their queries and transactions on:
+// the primary *sql.DB or a db.Router over it.
+type sqlDB interface {
+	boil.ContextExecutor
+	boil.ContextBeginner
+}
+
+// newRepositories returns the storage of the configured database.
+func newRepositories(driver string, database sqlDB) repository.Repositories {
+	switch driver {
+	case config.DriverMemory:
+		return memory.NewRepositories()
+	case config.DriverSQLite:
+		return sqlite.NewRepositories(database)
+	case config.DriverPostgres:
+		return postgres.NewRepositories(database)
+	}
+	return mysql.NewRepositories(database)
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kogamitora/todo/internal/config"
+	"github.com/kogamitora/todo/internal/db"
+)
+
+func newMigrateCmd() *cobra.Command {
+	migrateCmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Manage the database schema",
+		Long: `Manage the database schema with the migrations built into the server,
+or those in --migrations-dir. The database is read from the same settings
+as the server's.`,
+	}
+
+	migrateCmd.AddCommand(&cobra.Command{
+		Use:   "up",
+		Short: "Apply all pending migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+			cfg, err := loadConfig(cmd.Flags(), logger)
+			if err != nil {
+				return err
+			}
+			return migrateUp(cmd.Context(), cfg, logger)
+		},
+	})
+
+	migrateCmd.AddCommand(&cobra.Command{
+		Use:   "down [steps]",
+		Short: "Revert the last migrations (1 by default)",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			steps := 1
+			if len(args) == 1 {
+				n, err := strconv.Atoi(args[0])
+				if err != nil || n < 1 {
+					return fmt.Errorf("steps must be a positive number: %s", args[0])
+				}
+				steps = n
+			}
+			cmd.SilenceUsage = true
+			return withMigrator(cmd, func(mg *db.Migrator) error {
+				if err := mg.Down(cmd.Context(), steps); err != nil {
+					return err
+				}
+				version, _, err := mg.Version()
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "reverted %d migration(s), schema version is now %d\n", steps, version)
+				return nil
+			})
+		},
+	})
+
+	migrateCmd.AddCommand(&cobra.Command{
+		Use:   "status",
+		Short: "List the migrations and whether they are applied",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return withMigrator(cmd, func(mg *db.Migrator) error {
+				version, dirty, err := mg.Version()
+				if err != nil {
+					return err
+				}
+				list, err := mg.Migrations()
+				if err != nil {
+					return err
+				}
+
+				w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+				fmt.Fprintln(w, "VERSION\tNAME\tSTATUS")
+				for _, m := range list {
+					status := "pending"
+					switch {
+					case m.Version == version && dirty:
+						status = "dirty"
+					case m.Version <= version:
+						status = "applied"
+					}
+					fmt.Fprintf(w, "%06d\t%s\t%s\n", m.Version, m.Name, status)
+				}
+				return w.Flush()
+			})
+		},
+	})
+
+	migrateCmd.AddCommand(&cobra.Command{
+		Use:   "version",
+		Short: "Print the current schema version",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return withMigrator(cmd, func(mg *db.Migrator) error {
+				version, dirty, err := mg.Version()
+				if err != nil {
+					return err
+				}
+				if dirty {
+					fmt.Fprintf(cmd.OutOrStdout(), "%d (dirty)\n", version)
+				} else {
+					fmt.Fprintln(cmd.OutOrStdout(), version)
+				}
+				return nil
+			})
+		},
+	})
+
+	return migrateCmd
+}
+
+// migrateUp applies the pending migrations of cfg and logs the versions
+// before and after.
+func migrateUp(ctx context.Context, cfg *config.Config, logger *slog.Logger) error {
+	mg, err := db.NewMigrator(ctx, cfg.Database.Driver, cfg.GetDSN(), db.Migrations(cfg.Database.Driver, cfg.Database.MigrationsDir))
+	if err != nil {
+		return err
+	}
+	defer mg.Close()
+
+	from, to, err := mg.Up(ctx)
+	if err != nil {
+		return err
+	}
+	if from == to {
+		logger.Info("database schema is up to date", "version", to)
+	} else {
+		logger.Info("migrated database schema", "from", from, "to", to)
+	}
+	return nil
+}
+
+// withMigrator runs fn with a migrator for the configuration of cmd.
+func withMigrator(cmd *cobra.Command, fn func(*db.Migrator) error) error {
+	cfg, err := loadConfig(cmd.Flags(), slog.New(slog.NewTextHandler(os.Stderr, nil)))
+	if err != nil {
+		return err
+	}
+	mg, err := db.NewMigrator(cmd.Context(), cfg.Database.Driver, cfg.GetDSN(), db.Migrations(cfg.Database.Driver, cfg.Database.MigrationsDir))
+	if err != nil {
+		return err
+	}
+	defer mg.Close()
+	return fn(mg)
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/spf13/pflag"
+
+	"github.com/kogamitora/todo/internal/config"
+	"github.com/kogamitora/todo/internal/cors"
+	"github.com/kogamitora/todo/internal/logging"
+	"github.com/kogamitora/todo/internal/ratelimit"
+)
+
+// reloader applies the settings tagged reload:"true" to the running server
+// when the configuration changes. The other settings are only reported.
+type reloader struct {
+	flags  *pflag.FlagSet
+	logger *slog.Logger
+
+	level      *slog.LevelVar
+	limiter    *ratelimit.Limiter
+	cors       *cors.Handler
+	metrics    *atomic.Bool
+	reflection *atomic.Bool
+
+	mu      sync.Mutex
+	current *config.Config
+	// pending holds the new value of each setting that changed but needs a
+	// restart, so that it is reported once rather than on every reload.
+	pending map[string]string
+}
+
+// reload reads the configuration again. An invalid configuration is
+// rejected as a whole, leaving the running one in place.
+func (r *reloader) reload() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	next, err := loadConfig(r.flags, r.logger)
+	if err == nil {
+		err = next.Validate()
+	}
+	if err != nil {
+		r.logger.Error("rejected config reload, keeping current config", "error", err)
+		return
+	}
+
+	changed := false
+	pending := make(map[string]string)
+	for _, c := range config.Diff(r.current, next) {
+		if c.Reloaded {
+			r.logger.Info("config changed", "key", c.Key, "old", c.Old, "new", c.New)
+			changed = true
+			continue
+		}
+		// the running server keeps the old value, so the change shows up
+		// again on every reload until the restart
+		pending[c.Key] = c.New
+		if old, ok := r.pending[c.Key]; !ok || old != c.New {
+			r.logger.Warn("config change requires a restart", "key", c.Key, "old", c.Old, "new", c.New)
+			changed = true
+		}
+	}
+	r.pending = pending
+	if !changed {
+		r.logger.Info("config reloaded, nothing changed")
+		return
+	}
+
+	r.current = r.current.WithReloadable(next)
+	r.apply(r.current)
+}
+
+// apply sets the reloadable settings of cfg, which has been validated.
+func (r *reloader) apply(cfg *config.Config) {
+	if level, err := logging.ParseLevel(cfg.Log.Level); err == nil {
+		r.level.Set(level)
+	}
+	r.limiter.SetLimits(cfg.Limits.RequestsPerSecond, cfg.Limits.Burst)
+	r.cors.SetOrigins(cfg.Server.CORS.AllowedOrigins)
+	r.metrics.Store(cfg.Features.Metrics)
+	r.reflection.Store(cfg.Features.Reflection)
+}
+
+// gate serves 404 Not Found instead of h while enabled is false.
+func gate(enabled *atomic.Bool, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !enabled.Load() {
+			http.NotFound(w, r)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
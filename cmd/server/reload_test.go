@@ -0,0 +1,142 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/grpchealth"
+	"connectrpc.com/grpcreflect"
+
+	"github.com/kogamitora/todo/internal/config"
+	"github.com/kogamitora/todo/internal/cors"
+	"github.com/kogamitora/todo/internal/ratelimit"
+)
+
+// newTestReloader returns a reloader of a server started with the memory
+// store, and the log it writes.
+func newTestReloader(t *testing.T) (*reloader, *bytes.Buffer) {
+	t.Helper()
+	t.Setenv("SERVER_HOST", "127.0.0.1")
+	t.Setenv("SERVER_PORT", "8080")
+	t.Setenv("DB_DRIVER", "memory")
+	cfg, err := config.Load(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	var log bytes.Buffer
+	r := &reloader{
+		logger:     slog.New(slog.NewTextHandler(&log, nil)),
+		level:      new(slog.LevelVar),
+		limiter:    ratelimit.New(cfg.Limits.RequestsPerSecond, cfg.Limits.Burst),
+		cors:       cors.New(cfg.Server.CORS.AllowedOrigins),
+		metrics:    new(atomic.Bool),
+		reflection: new(atomic.Bool),
+		current:    cfg,
+	}
+	r.apply(cfg)
+	return r, &log
+}
+
+func TestReloadWarnsOncePerRestartChange(t *testing.T) {
+	r, log := newTestReloader(t)
+	warnings := func() int {
+		return strings.Count(log.String(), "config change requires a restart")
+	}
+
+	t.Setenv("SHUTDOWN_TIMEOUT", "1m")
+	r.reload()
+	r.reload()
+	if n := warnings(); n != 1 {
+		t.Errorf("%d warnings after two reloads, want 1:\n%s", n, log)
+	}
+	if r.current.Server.ShutdownTimeout.String() != "30s" {
+		t.Errorf("shutdown timeout = %s, want the running 30s", r.current.Server.ShutdownTimeout)
+	}
+
+	t.Setenv("SHUTDOWN_TIMEOUT", "2m")
+	r.reload()
+	if n := warnings(); n != 2 {
+		t.Errorf("%d warnings, want another one for a new value:\n%s", n, log)
+	}
+
+	// back to the running value, then changed again
+	t.Setenv("SHUTDOWN_TIMEOUT", "30s")
+	r.reload()
+	t.Setenv("SHUTDOWN_TIMEOUT", "2m")
+	r.reload()
+	if n := warnings(); n != 3 {
+		t.Errorf("%d warnings, want another one after the change was undone:\n%s", n, log)
+	}
+}
+
+func TestGateFollowsReload(t *testing.T) {
+	r, _ := newTestReloader(t)
+	reflector := grpcreflect.NewStaticReflector(grpchealth.HealthV1ServiceName)
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", gate(r.metrics, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte("todo_todos_open 0\n"))
+	})))
+	reflectionPath, reflectionH := grpcreflect.NewHandlerV1(reflector)
+	mux.Handle(reflectionPath, gate(r.reflection, reflectionH))
+	reflectionAlphaPath, reflectionAlphaH := grpcreflect.NewHandlerV1Alpha(reflector)
+	mux.Handle(reflectionAlphaPath, gate(r.reflection, reflectionAlphaH))
+	// reflection streams in both directions, which needs HTTP/2
+	srv := httptest.NewUnstartedServer(mux)
+	srv.EnableHTTP2 = true
+	srv.StartTLS()
+	defer srv.Close()
+
+	metricsStatus := func() int {
+		t.Helper()
+		res, err := srv.Client().Get(srv.URL + "/metrics")
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		return res.StatusCode
+	}
+	// the client sees the 404 of a closed gate as CodeUnimplemented
+	reflectionCode := func() connect.Code {
+		t.Helper()
+		stream := grpcreflect.NewClient(srv.Client(), srv.URL).NewStream(context.Background())
+		defer stream.Close()
+		names, err := stream.ListServices()
+		if err != nil {
+			return connect.CodeOf(err)
+		}
+		if !slices.Contains(names, grpchealth.HealthV1ServiceName) {
+			t.Errorf("services = %v, want %s", names, grpchealth.HealthV1ServiceName)
+		}
+		return 0
+	}
+
+	if status, code := metricsStatus(), reflectionCode(); status != http.StatusOK || code != 0 {
+		t.Errorf("enabled: metrics %d, reflection %v; want 200 and served", status, code)
+	}
+
+	t.Setenv("METRICS_ENABLED", "false")
+	t.Setenv("GRPC_REFLECTION", "false")
+	r.reload()
+	if status, code := metricsStatus(), reflectionCode(); status != http.StatusNotFound || code != connect.CodeUnimplemented {
+		t.Errorf("disabled: metrics %d, reflection %v; want 404 for both", status, code)
+	}
+
+	t.Setenv("METRICS_ENABLED", "true")
+	t.Setenv("GRPC_REFLECTION", "true")
+	r.reload()
+	if status, code := metricsStatus(), reflectionCode(); status != http.StatusOK || code != 0 {
+		t.Errorf("enabled again: metrics %d, reflection %v; want 200 and served", status, code)
+	}
+}
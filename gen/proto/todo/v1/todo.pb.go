@@ -13,6 +13,7 @@ import (
 	sync "sync"
 	unsafe "unsafe"
 
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -33,6 +34,9 @@ const (
 	Status_STATUS_UNSPECIFIED Status = 0
 	Status_STATUS_INCOMPLETE  Status = 1
 	Status_STATUS_COMPLETED   Status = 2
+	Status_STATUS_IN_PROGRESS Status = 3
+	Status_STATUS_BLOCKED     Status = 4
+	Status_STATUS_CANCELLED   Status = 5
 )
 
 // Enum value maps for Status.
@@ -41,11 +45,17 @@ var (
 		0: "STATUS_UNSPECIFIED",
 		1: "STATUS_INCOMPLETE",
 		2: "STATUS_COMPLETED",
+		3: "STATUS_IN_PROGRESS",
+		4: "STATUS_BLOCKED",
+		5: "STATUS_CANCELLED",
 	}
 	Status_value = map[string]int32{
 		"STATUS_UNSPECIFIED": 0,
 		"STATUS_INCOMPLETE":  1,
 		"STATUS_COMPLETED":   2,
+		"STATUS_IN_PROGRESS": 3,
+		"STATUS_BLOCKED":     4,
+		"STATUS_CANCELLED":   5,
 	}
 )
 
@@ -126,16 +136,120 @@ func (SortOrder) EnumDescriptor() ([]byte, []int) {
 	return file_proto_todo_v1_todo_proto_rawDescGZIP(), []int{1}
 }
 
+// Sort Enum
+type Sort int32
+
+const (
+	Sort_SORT_UNSPECIFIED Sort = 0
+	// user-defined order set with MoveTodo
+	Sort_SORT_MANUAL Sort = 1
+)
+
+// Enum value maps for Sort.
+var (
+	Sort_name = map[int32]string{
+		0: "SORT_UNSPECIFIED",
+		1: "SORT_MANUAL",
+	}
+	Sort_value = map[string]int32{
+		"SORT_UNSPECIFIED": 0,
+		"SORT_MANUAL":      1,
+	}
+)
+
+func (x Sort) Enum() *Sort {
+	p := new(Sort)
+	*p = x
+	return p
+}
+
+func (x Sort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Sort) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_todo_v1_todo_proto_enumTypes[2].Descriptor()
+}
+
+func (Sort) Type() protoreflect.EnumType {
+	return &file_proto_todo_v1_todo_proto_enumTypes[2]
+}
+
+func (x Sort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Sort.Descriptor instead.
+func (Sort) EnumDescriptor() ([]byte, []int) {
+	return file_proto_todo_v1_todo_proto_rawDescGZIP(), []int{2}
+}
+
+// Dependency Filter Enum
+type DependencyFilter int32
+
+const (
+	DependencyFilter_DEPENDENCY_FILTER_UNSPECIFIED DependencyFilter = 0
+	// todos with no open blockers
+	DependencyFilter_DEPENDENCY_FILTER_READY DependencyFilter = 1
+	// todos with at least one open blocker
+	DependencyFilter_DEPENDENCY_FILTER_BLOCKED DependencyFilter = 2
+)
+
+// Enum value maps for DependencyFilter.
+var (
+	DependencyFilter_name = map[int32]string{
+		0: "DEPENDENCY_FILTER_UNSPECIFIED",
+		1: "DEPENDENCY_FILTER_READY",
+		2: "DEPENDENCY_FILTER_BLOCKED",
+	}
+	DependencyFilter_value = map[string]int32{
+		"DEPENDENCY_FILTER_UNSPECIFIED": 0,
+		"DEPENDENCY_FILTER_READY":       1,
+		"DEPENDENCY_FILTER_BLOCKED":     2,
+	}
+)
+
+func (x DependencyFilter) Enum() *DependencyFilter {
+	p := new(DependencyFilter)
+	*p = x
+	return p
+}
+
+func (x DependencyFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DependencyFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_todo_v1_todo_proto_enumTypes[3].Descriptor()
+}
+
+func (DependencyFilter) Type() protoreflect.EnumType {
+	return &file_proto_todo_v1_todo_proto_enumTypes[3]
+}
+
+func (x DependencyFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DependencyFilter.Descriptor instead.
+func (DependencyFilter) EnumDescriptor() ([]byte, []int) {
+	return file_proto_todo_v1_todo_proto_rawDescGZIP(), []int{3}
+}
+
 // Todo Interface
 type Todo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	Status        Status                 `protobuf:"varint,5,opt,name=status,proto3,enum=todo.v1.Status" json:"status,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	DueDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Status      Status                 `protobuf:"varint,5,opt,name=status,proto3,enum=todo.v1.Status" json:"status,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// IDs of the todos that must be finished before this one
+	BlockedBy []int64 `protobuf:"varint,8,rep,packed,name=blocked_by,json=blockedBy,proto3" json:"blocked_by,omitempty"`
+	// sort key within the status column, compared byte-wise
+	Position      string `protobuf:"bytes,9,opt,name=position,proto3" json:"position,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -219,10 +333,27 @@ func (x *Todo) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Todo) GetBlockedBy() []int64 {
+	if x != nil {
+		return x.BlockedBy
+	}
+	return nil
+}
+
+func (x *Todo) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
 type CreateTodoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// stored in a VARCHAR(255) column
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// stored in a TEXT column
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// due dates are stored as MySQL TIMESTAMPs, which end in January 2038
 	DueDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -620,11 +751,13 @@ func (x *DeleteTodoResponse) GetMessage() *emptypb.Empty {
 }
 
 type GetTodosRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StatusFilter  *Status                `protobuf:"varint,1,opt,name=status_filter,json=statusFilter,proto3,enum=todo.v1.Status,oneof" json:"status_filter,omitempty"`
-	SortByDueDate *SortOrder             `protobuf:"varint,2,opt,name=sort_by_due_date,json=sortByDueDate,proto3,enum=todo.v1.SortOrder,oneof" json:"sort_by_due_date,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StatusFilter     *Status                `protobuf:"varint,1,opt,name=status_filter,json=statusFilter,proto3,enum=todo.v1.Status,oneof" json:"status_filter,omitempty"`
+	SortByDueDate    *SortOrder             `protobuf:"varint,2,opt,name=sort_by_due_date,json=sortByDueDate,proto3,enum=todo.v1.SortOrder,oneof" json:"sort_by_due_date,omitempty"`
+	DependencyFilter *DependencyFilter      `protobuf:"varint,3,opt,name=dependency_filter,json=dependencyFilter,proto3,enum=todo.v1.DependencyFilter,oneof" json:"dependency_filter,omitempty"`
+	Sort             *Sort                  `protobuf:"varint,4,opt,name=sort,proto3,enum=todo.v1.Sort,oneof" json:"sort,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *GetTodosRequest) Reset() {
@@ -671,6 +804,20 @@ func (x *GetTodosRequest) GetSortByDueDate() SortOrder {
 	return SortOrder_SORT_ORDER_UNSPECIFIED
 }
 
+func (x *GetTodosRequest) GetDependencyFilter() DependencyFilter {
+	if x != nil && x.DependencyFilter != nil {
+		return *x.DependencyFilter
+	}
+	return DependencyFilter_DEPENDENCY_FILTER_UNSPECIFIED
+}
+
+func (x *GetTodosRequest) GetSort() Sort {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return Sort_SORT_UNSPECIFIED
+}
+
 type GetTodosResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Todos         []*Todo                `protobuf:"bytes,1,rep,name=todos,proto3" json:"todos,omitempty"`
@@ -715,11 +862,309 @@ func (x *GetTodosResponse) GetTodos() []*Todo {
 	return nil
 }
 
+type AddDependencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TodoId        int64                  `protobuf:"varint,1,opt,name=todo_id,json=todoId,proto3" json:"todo_id,omitempty"`
+	BlockedById   int64                  `protobuf:"varint,2,opt,name=blocked_by_id,json=blockedById,proto3" json:"blocked_by_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDependencyRequest) Reset() {
+	*x = AddDependencyRequest{}
+	mi := &file_proto_todo_v1_todo_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDependencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDependencyRequest) ProtoMessage() {}
+
+func (x *AddDependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_todo_v1_todo_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDependencyRequest.ProtoReflect.Descriptor instead.
+func (*AddDependencyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_todo_v1_todo_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddDependencyRequest) GetTodoId() int64 {
+	if x != nil {
+		return x.TodoId
+	}
+	return 0
+}
+
+func (x *AddDependencyRequest) GetBlockedById() int64 {
+	if x != nil {
+		return x.BlockedById
+	}
+	return 0
+}
+
+type AddDependencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todo          *Todo                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDependencyResponse) Reset() {
+	*x = AddDependencyResponse{}
+	mi := &file_proto_todo_v1_todo_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDependencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDependencyResponse) ProtoMessage() {}
+
+func (x *AddDependencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_todo_v1_todo_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDependencyResponse.ProtoReflect.Descriptor instead.
+func (*AddDependencyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_todo_v1_todo_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddDependencyResponse) GetTodo() *Todo {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+type RemoveDependencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TodoId        int64                  `protobuf:"varint,1,opt,name=todo_id,json=todoId,proto3" json:"todo_id,omitempty"`
+	BlockedById   int64                  `protobuf:"varint,2,opt,name=blocked_by_id,json=blockedById,proto3" json:"blocked_by_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDependencyRequest) Reset() {
+	*x = RemoveDependencyRequest{}
+	mi := &file_proto_todo_v1_todo_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDependencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDependencyRequest) ProtoMessage() {}
+
+func (x *RemoveDependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_todo_v1_todo_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDependencyRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDependencyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_todo_v1_todo_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoveDependencyRequest) GetTodoId() int64 {
+	if x != nil {
+		return x.TodoId
+	}
+	return 0
+}
+
+func (x *RemoveDependencyRequest) GetBlockedById() int64 {
+	if x != nil {
+		return x.BlockedById
+	}
+	return 0
+}
+
+type RemoveDependencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todo          *Todo                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDependencyResponse) Reset() {
+	*x = RemoveDependencyResponse{}
+	mi := &file_proto_todo_v1_todo_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDependencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDependencyResponse) ProtoMessage() {}
+
+func (x *RemoveDependencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_todo_v1_todo_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDependencyResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDependencyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_todo_v1_todo_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveDependencyResponse) GetTodo() *Todo {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
+type MoveTodoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// place the todo directly before this todo
+	BeforeId *int64 `protobuf:"varint,2,opt,name=before_id,json=beforeId,proto3,oneof" json:"before_id,omitempty"`
+	// place the todo directly after this todo
+	AfterId       *int64 `protobuf:"varint,3,opt,name=after_id,json=afterId,proto3,oneof" json:"after_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTodoRequest) Reset() {
+	*x = MoveTodoRequest{}
+	mi := &file_proto_todo_v1_todo_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTodoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTodoRequest) ProtoMessage() {}
+
+func (x *MoveTodoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_todo_v1_todo_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTodoRequest.ProtoReflect.Descriptor instead.
+func (*MoveTodoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_todo_v1_todo_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MoveTodoRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MoveTodoRequest) GetBeforeId() int64 {
+	if x != nil && x.BeforeId != nil {
+		return *x.BeforeId
+	}
+	return 0
+}
+
+func (x *MoveTodoRequest) GetAfterId() int64 {
+	if x != nil && x.AfterId != nil {
+		return *x.AfterId
+	}
+	return 0
+}
+
+type MoveTodoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Todo          *Todo                  `protobuf:"bytes,1,opt,name=todo,proto3" json:"todo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTodoResponse) Reset() {
+	*x = MoveTodoResponse{}
+	mi := &file_proto_todo_v1_todo_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTodoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTodoResponse) ProtoMessage() {}
+
+func (x *MoveTodoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_todo_v1_todo_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTodoResponse.ProtoReflect.Descriptor instead.
+func (*MoveTodoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_todo_v1_todo_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MoveTodoResponse) GetTodo() *Todo {
+	if x != nil {
+		return x.Todo
+	}
+	return nil
+}
+
 var File_proto_todo_v1_todo_proto protoreflect.FileDescriptor
 
 const file_proto_todo_v1_todo_proto_rawDesc = "" +
 	"\n" +
-	"\x18proto/todo/v1/todo.proto\x12\atodo.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa4\x02\n" +
+	"\x18proto/todo/v1/todo.proto\x12\atodo.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdf\x02\n" +
 	"\x04Todo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -729,48 +1174,88 @@ const file_proto_todo_v1_todo_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x82\x01\n" +
-	"\x11CreateTodoRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x125\n" +
-	"\bdue_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\"7\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"blocked_by\x18\b \x03(\x03R\tblockedBy\x12\x1a\n" +
+	"\bposition\x18\t \x01(\tR\bposition\"\xac\x01\n" +
+	"\x11CreateTodoRequest\x12!\n" +
+	"\x05title\x18\x01 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x18\xff\x01R\x05title\x12+\n" +
+	"\vdescription\x18\x02 \x01(\tB\t\xbaH\x06r\x04(\xff\xff\x03R\vdescription\x12G\n" +
+	"\bdue_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x10\xbaH\r\xb2\x01\n" +
+	"\x1a\x06\b\x80\x80\x80\x80\b*\x00R\adueDate\"7\n" +
 	"\x12CreateTodoResponse\x12!\n" +
-	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TodoR\x04todo\" \n" +
-	"\x0eGetTodoRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"4\n" +
+	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TodoR\x04todo\")\n" +
+	"\x0eGetTodoRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"4\n" +
 	"\x0fGetTodoResponse\x12!\n" +
-	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TodoR\x04todo\"\x81\x02\n" +
-	"\x11UpdateTodoRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
-	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12:\n" +
-	"\bdue_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\adueDate\x88\x01\x01\x12,\n" +
-	"\x06status\x18\x05 \x01(\x0e2\x0f.todo.v1.StatusH\x03R\x06status\x88\x01\x01B\b\n" +
+	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TodoR\x04todo\"\xbf\x02\n" +
+	"\x11UpdateTodoRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12%\n" +
+	"\x05title\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x05title\x88\x01\x01\x120\n" +
+	"\vdescription\x18\x03 \x01(\tB\t\xbaH\x06r\x04(\xff\xff\x03H\x01R\vdescription\x88\x01\x01\x12L\n" +
+	"\bdue_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x10\xbaH\r\xb2\x01\n" +
+	"\x1a\x06\b\x80\x80\x80\x80\b*\x00H\x02R\adueDate\x88\x01\x01\x128\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x0f.todo.v1.StatusB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00H\x03R\x06status\x88\x01\x01B\b\n" +
 	"\x06_titleB\x0e\n" +
 	"\f_descriptionB\v\n" +
 	"\t_due_dateB\t\n" +
 	"\a_status\"7\n" +
 	"\x12UpdateTodoResponse\x12!\n" +
-	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TodoR\x04todo\"#\n" +
-	"\x11DeleteTodoRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"F\n" +
+	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TodoR\x04todo\",\n" +
+	"\x11DeleteTodoRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"F\n" +
 	"\x12DeleteTodoResponse\x120\n" +
-	"\amessage\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\amessage\"\xb5\x01\n" +
-	"\x0fGetTodosRequest\x129\n" +
-	"\rstatus_filter\x18\x01 \x01(\x0e2\x0f.todo.v1.StatusH\x00R\fstatusFilter\x88\x01\x01\x12@\n" +
-	"\x10sort_by_due_date\x18\x02 \x01(\x0e2\x12.todo.v1.SortOrderH\x01R\rsortByDueDate\x88\x01\x01B\x10\n" +
+	"\amessage\x18\x01 \x01(\v2\x16.google.protobuf.EmptyR\amessage\"\xf1\x02\n" +
+	"\x0fGetTodosRequest\x12C\n" +
+	"\rstatus_filter\x18\x01 \x01(\x0e2\x0f.todo.v1.StatusB\b\xbaH\x05\x82\x01\x02\x10\x01H\x00R\fstatusFilter\x88\x01\x01\x12J\n" +
+	"\x10sort_by_due_date\x18\x02 \x01(\x0e2\x12.todo.v1.SortOrderB\b\xbaH\x05\x82\x01\x02\x10\x01H\x01R\rsortByDueDate\x88\x01\x01\x12U\n" +
+	"\x11dependency_filter\x18\x03 \x01(\x0e2\x19.todo.v1.DependencyFilterB\b\xbaH\x05\x82\x01\x02\x10\x01H\x02R\x10dependencyFilter\x88\x01\x01\x120\n" +
+	"\x04sort\x18\x04 \x01(\x0e2\r.todo.v1.SortB\b\xbaH\x05\x82\x01\x02\x10\x01H\x03R\x04sort\x88\x01\x01B\x10\n" +
 	"\x0e_status_filterB\x13\n" +
-	"\x11_sort_by_due_date\"7\n" +
+	"\x11_sort_by_due_dateB\x14\n" +
+	"\x12_dependency_filterB\a\n" +
+	"\x05_sort\"7\n" +
 	"\x10GetTodosResponse\x12#\n" +
-	"\x05todos\x18\x01 \x03(\v2\r.todo.v1.TodoR\x05todos*M\n" +
+	"\x05todos\x18\x01 \x03(\v2\r.todo.v1.TodoR\x05todos\"e\n" +
+	"\x14AddDependencyRequest\x12 \n" +
+	"\atodo_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06todoId\x12+\n" +
+	"\rblocked_by_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\vblockedById\":\n" +
+	"\x15AddDependencyResponse\x12!\n" +
+	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TodoR\x04todo\"h\n" +
+	"\x17RemoveDependencyRequest\x12 \n" +
+	"\atodo_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x06todoId\x12+\n" +
+	"\rblocked_by_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\vblockedById\"=\n" +
+	"\x18RemoveDependencyResponse\x12!\n" +
+	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TodoR\x04todo\"\x99\x01\n" +
+	"\x0fMoveTodoRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12)\n" +
+	"\tbefore_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\bbeforeId\x88\x01\x01\x12'\n" +
+	"\bafter_id\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x01R\aafterId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_before_idB\v\n" +
+	"\t_after_id\"5\n" +
+	"\x10MoveTodoResponse\x12!\n" +
+	"\x04todo\x18\x01 \x01(\v2\r.todo.v1.TodoR\x04todo*\x8f\x01\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11STATUS_INCOMPLETE\x10\x01\x12\x14\n" +
-	"\x10STATUS_COMPLETED\x10\x02*P\n" +
+	"\x10STATUS_COMPLETED\x10\x02\x12\x16\n" +
+	"\x12STATUS_IN_PROGRESS\x10\x03\x12\x12\n" +
+	"\x0eSTATUS_BLOCKED\x10\x04\x12\x14\n" +
+	"\x10STATUS_CANCELLED\x10\x05*P\n" +
 	"\tSortOrder\x12\x1a\n" +
 	"\x16SORT_ORDER_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eSORT_ORDER_ASC\x10\x01\x12\x13\n" +
-	"\x0fSORT_ORDER_DESC\x10\x022\xe1\x02\n" +
+	"\x0fSORT_ORDER_DESC\x10\x02*-\n" +
+	"\x04Sort\x12\x14\n" +
+	"\x10SORT_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vSORT_MANUAL\x10\x01*q\n" +
+	"\x10DependencyFilter\x12!\n" +
+	"\x1dDEPENDENCY_FILTER_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17DEPENDENCY_FILTER_READY\x10\x01\x12\x1d\n" +
+	"\x19DEPENDENCY_FILTER_BLOCKED\x10\x022\xcb\x04\n" +
 	"\vTodoService\x12E\n" +
 	"\n" +
 	"CreateTodo\x12\x1a.todo.v1.CreateTodoRequest\x1a\x1b.todo.v1.CreateTodoResponse\x12<\n" +
@@ -779,7 +1264,10 @@ const file_proto_todo_v1_todo_proto_rawDesc = "" +
 	"UpdateTodo\x12\x1a.todo.v1.UpdateTodoRequest\x1a\x1b.todo.v1.UpdateTodoResponse\x12E\n" +
 	"\n" +
 	"DeleteTodo\x12\x1a.todo.v1.DeleteTodoRequest\x1a\x1b.todo.v1.DeleteTodoResponse\x12?\n" +
-	"\bGetTodos\x12\x18.todo.v1.GetTodosRequest\x1a\x19.todo.v1.GetTodosResponseB.Z,github.com/kogamitora/todo/gen/proto/todo/v1b\x06proto3"
+	"\bGetTodos\x12\x18.todo.v1.GetTodosRequest\x1a\x19.todo.v1.GetTodosResponse\x12N\n" +
+	"\rAddDependency\x12\x1d.todo.v1.AddDependencyRequest\x1a\x1e.todo.v1.AddDependencyResponse\x12W\n" +
+	"\x10RemoveDependency\x12 .todo.v1.RemoveDependencyRequest\x1a!.todo.v1.RemoveDependencyResponse\x12?\n" +
+	"\bMoveTodo\x12\x18.todo.v1.MoveTodoRequest\x1a\x19.todo.v1.MoveTodoResponseB.Z,github.com/kogamitora/todo/gen/proto/todo/v1b\x06proto3"
 
 var (
 	file_proto_todo_v1_todo_proto_rawDescOnce sync.Once
@@ -793,55 +1281,74 @@ func file_proto_todo_v1_todo_proto_rawDescGZIP() []byte {
 	return file_proto_todo_v1_todo_proto_rawDescData
 }
 
-var file_proto_todo_v1_todo_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_todo_v1_todo_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_todo_v1_todo_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_todo_v1_todo_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_proto_todo_v1_todo_proto_goTypes = []any{
-	(Status)(0),                   // 0: todo.v1.Status
-	(SortOrder)(0),                // 1: todo.v1.SortOrder
-	(*Todo)(nil),                  // 2: todo.v1.Todo
-	(*CreateTodoRequest)(nil),     // 3: todo.v1.CreateTodoRequest
-	(*CreateTodoResponse)(nil),    // 4: todo.v1.CreateTodoResponse
-	(*GetTodoRequest)(nil),        // 5: todo.v1.GetTodoRequest
-	(*GetTodoResponse)(nil),       // 6: todo.v1.GetTodoResponse
-	(*UpdateTodoRequest)(nil),     // 7: todo.v1.UpdateTodoRequest
-	(*UpdateTodoResponse)(nil),    // 8: todo.v1.UpdateTodoResponse
-	(*DeleteTodoRequest)(nil),     // 9: todo.v1.DeleteTodoRequest
-	(*DeleteTodoResponse)(nil),    // 10: todo.v1.DeleteTodoResponse
-	(*GetTodosRequest)(nil),       // 11: todo.v1.GetTodosRequest
-	(*GetTodosResponse)(nil),      // 12: todo.v1.GetTodosResponse
-	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 14: google.protobuf.Empty
+	(Status)(0),                      // 0: todo.v1.Status
+	(SortOrder)(0),                   // 1: todo.v1.SortOrder
+	(Sort)(0),                        // 2: todo.v1.Sort
+	(DependencyFilter)(0),            // 3: todo.v1.DependencyFilter
+	(*Todo)(nil),                     // 4: todo.v1.Todo
+	(*CreateTodoRequest)(nil),        // 5: todo.v1.CreateTodoRequest
+	(*CreateTodoResponse)(nil),       // 6: todo.v1.CreateTodoResponse
+	(*GetTodoRequest)(nil),           // 7: todo.v1.GetTodoRequest
+	(*GetTodoResponse)(nil),          // 8: todo.v1.GetTodoResponse
+	(*UpdateTodoRequest)(nil),        // 9: todo.v1.UpdateTodoRequest
+	(*UpdateTodoResponse)(nil),       // 10: todo.v1.UpdateTodoResponse
+	(*DeleteTodoRequest)(nil),        // 11: todo.v1.DeleteTodoRequest
+	(*DeleteTodoResponse)(nil),       // 12: todo.v1.DeleteTodoResponse
+	(*GetTodosRequest)(nil),          // 13: todo.v1.GetTodosRequest
+	(*GetTodosResponse)(nil),         // 14: todo.v1.GetTodosResponse
+	(*AddDependencyRequest)(nil),     // 15: todo.v1.AddDependencyRequest
+	(*AddDependencyResponse)(nil),    // 16: todo.v1.AddDependencyResponse
+	(*RemoveDependencyRequest)(nil),  // 17: todo.v1.RemoveDependencyRequest
+	(*RemoveDependencyResponse)(nil), // 18: todo.v1.RemoveDependencyResponse
+	(*MoveTodoRequest)(nil),          // 19: todo.v1.MoveTodoRequest
+	(*MoveTodoResponse)(nil),         // 20: todo.v1.MoveTodoResponse
+	(*timestamppb.Timestamp)(nil),    // 21: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),            // 22: google.protobuf.Empty
 }
 var file_proto_todo_v1_todo_proto_depIdxs = []int32{
-	13, // 0: todo.v1.Todo.due_date:type_name -> google.protobuf.Timestamp
+	21, // 0: todo.v1.Todo.due_date:type_name -> google.protobuf.Timestamp
 	0,  // 1: todo.v1.Todo.status:type_name -> todo.v1.Status
-	13, // 2: todo.v1.Todo.created_at:type_name -> google.protobuf.Timestamp
-	13, // 3: todo.v1.Todo.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 4: todo.v1.CreateTodoRequest.due_date:type_name -> google.protobuf.Timestamp
-	2,  // 5: todo.v1.CreateTodoResponse.todo:type_name -> todo.v1.Todo
-	2,  // 6: todo.v1.GetTodoResponse.todo:type_name -> todo.v1.Todo
-	13, // 7: todo.v1.UpdateTodoRequest.due_date:type_name -> google.protobuf.Timestamp
+	21, // 2: todo.v1.Todo.created_at:type_name -> google.protobuf.Timestamp
+	21, // 3: todo.v1.Todo.updated_at:type_name -> google.protobuf.Timestamp
+	21, // 4: todo.v1.CreateTodoRequest.due_date:type_name -> google.protobuf.Timestamp
+	4,  // 5: todo.v1.CreateTodoResponse.todo:type_name -> todo.v1.Todo
+	4,  // 6: todo.v1.GetTodoResponse.todo:type_name -> todo.v1.Todo
+	21, // 7: todo.v1.UpdateTodoRequest.due_date:type_name -> google.protobuf.Timestamp
 	0,  // 8: todo.v1.UpdateTodoRequest.status:type_name -> todo.v1.Status
-	2,  // 9: todo.v1.UpdateTodoResponse.todo:type_name -> todo.v1.Todo
-	14, // 10: todo.v1.DeleteTodoResponse.message:type_name -> google.protobuf.Empty
+	4,  // 9: todo.v1.UpdateTodoResponse.todo:type_name -> todo.v1.Todo
+	22, // 10: todo.v1.DeleteTodoResponse.message:type_name -> google.protobuf.Empty
 	0,  // 11: todo.v1.GetTodosRequest.status_filter:type_name -> todo.v1.Status
 	1,  // 12: todo.v1.GetTodosRequest.sort_by_due_date:type_name -> todo.v1.SortOrder
-	2,  // 13: todo.v1.GetTodosResponse.todos:type_name -> todo.v1.Todo
-	3,  // 14: todo.v1.TodoService.CreateTodo:input_type -> todo.v1.CreateTodoRequest
-	5,  // 15: todo.v1.TodoService.GetTodo:input_type -> todo.v1.GetTodoRequest
-	7,  // 16: todo.v1.TodoService.UpdateTodo:input_type -> todo.v1.UpdateTodoRequest
-	9,  // 17: todo.v1.TodoService.DeleteTodo:input_type -> todo.v1.DeleteTodoRequest
-	11, // 18: todo.v1.TodoService.GetTodos:input_type -> todo.v1.GetTodosRequest
-	4,  // 19: todo.v1.TodoService.CreateTodo:output_type -> todo.v1.CreateTodoResponse
-	6,  // 20: todo.v1.TodoService.GetTodo:output_type -> todo.v1.GetTodoResponse
-	8,  // 21: todo.v1.TodoService.UpdateTodo:output_type -> todo.v1.UpdateTodoResponse
-	10, // 22: todo.v1.TodoService.DeleteTodo:output_type -> todo.v1.DeleteTodoResponse
-	12, // 23: todo.v1.TodoService.GetTodos:output_type -> todo.v1.GetTodosResponse
-	19, // [19:24] is the sub-list for method output_type
-	14, // [14:19] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	3,  // 13: todo.v1.GetTodosRequest.dependency_filter:type_name -> todo.v1.DependencyFilter
+	2,  // 14: todo.v1.GetTodosRequest.sort:type_name -> todo.v1.Sort
+	4,  // 15: todo.v1.GetTodosResponse.todos:type_name -> todo.v1.Todo
+	4,  // 16: todo.v1.AddDependencyResponse.todo:type_name -> todo.v1.Todo
+	4,  // 17: todo.v1.RemoveDependencyResponse.todo:type_name -> todo.v1.Todo
+	4,  // 18: todo.v1.MoveTodoResponse.todo:type_name -> todo.v1.Todo
+	5,  // 19: todo.v1.TodoService.CreateTodo:input_type -> todo.v1.CreateTodoRequest
+	7,  // 20: todo.v1.TodoService.GetTodo:input_type -> todo.v1.GetTodoRequest
+	9,  // 21: todo.v1.TodoService.UpdateTodo:input_type -> todo.v1.UpdateTodoRequest
+	11, // 22: todo.v1.TodoService.DeleteTodo:input_type -> todo.v1.DeleteTodoRequest
+	13, // 23: todo.v1.TodoService.GetTodos:input_type -> todo.v1.GetTodosRequest
+	15, // 24: todo.v1.TodoService.AddDependency:input_type -> todo.v1.AddDependencyRequest
+	17, // 25: todo.v1.TodoService.RemoveDependency:input_type -> todo.v1.RemoveDependencyRequest
+	19, // 26: todo.v1.TodoService.MoveTodo:input_type -> todo.v1.MoveTodoRequest
+	6,  // 27: todo.v1.TodoService.CreateTodo:output_type -> todo.v1.CreateTodoResponse
+	8,  // 28: todo.v1.TodoService.GetTodo:output_type -> todo.v1.GetTodoResponse
+	10, // 29: todo.v1.TodoService.UpdateTodo:output_type -> todo.v1.UpdateTodoResponse
+	12, // 30: todo.v1.TodoService.DeleteTodo:output_type -> todo.v1.DeleteTodoResponse
+	14, // 31: todo.v1.TodoService.GetTodos:output_type -> todo.v1.GetTodosResponse
+	16, // 32: todo.v1.TodoService.AddDependency:output_type -> todo.v1.AddDependencyResponse
+	18, // 33: todo.v1.TodoService.RemoveDependency:output_type -> todo.v1.RemoveDependencyResponse
+	20, // 34: todo.v1.TodoService.MoveTodo:output_type -> todo.v1.MoveTodoResponse
+	27, // [27:35] is the sub-list for method output_type
+	19, // [19:27] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_proto_todo_v1_todo_proto_init() }
@@ -851,13 +1358,14 @@ func file_proto_todo_v1_todo_proto_init() {
 	}
 	file_proto_todo_v1_todo_proto_msgTypes[5].OneofWrappers = []any{}
 	file_proto_todo_v1_todo_proto_msgTypes[9].OneofWrappers = []any{}
+	file_proto_todo_v1_todo_proto_msgTypes[15].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_todo_v1_todo_proto_rawDesc), len(file_proto_todo_v1_todo_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   11,
+			NumEnums:      4,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,200 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: proto/todo/v1/comment.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// CommentServiceName is the fully-qualified name of the CommentService service.
+	CommentServiceName = "todo.v1.CommentService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// CommentServiceAddCommentProcedure is the fully-qualified name of the CommentService's AddComment
+	// RPC.
+	CommentServiceAddCommentProcedure = "/todo.v1.CommentService/AddComment"
+	// CommentServiceUpdateCommentProcedure is the fully-qualified name of the CommentService's
+	// UpdateComment RPC.
+	CommentServiceUpdateCommentProcedure = "/todo.v1.CommentService/UpdateComment"
+	// CommentServiceDeleteCommentProcedure is the fully-qualified name of the CommentService's
+	// DeleteComment RPC.
+	CommentServiceDeleteCommentProcedure = "/todo.v1.CommentService/DeleteComment"
+	// CommentServiceListCommentsProcedure is the fully-qualified name of the CommentService's
+	// ListComments RPC.
+	CommentServiceListCommentsProcedure = "/todo.v1.CommentService/ListComments"
+)
+
+// CommentServiceClient is a client for the todo.v1.CommentService service.
+type CommentServiceClient interface {
+	AddComment(context.Context, *connect.Request[v1.AddCommentRequest]) (*connect.Response[v1.AddCommentResponse], error)
+	// Only the author, the X-User of the AddComment request, may update or
+	// delete a comment.
+	UpdateComment(context.Context, *connect.Request[v1.UpdateCommentRequest]) (*connect.Response[v1.UpdateCommentResponse], error)
+	DeleteComment(context.Context, *connect.Request[v1.DeleteCommentRequest]) (*connect.Response[v1.DeleteCommentResponse], error)
+	ListComments(context.Context, *connect.Request[v1.ListCommentsRequest]) (*connect.Response[v1.ListCommentsResponse], error)
+}
+
+// NewCommentServiceClient constructs a client for the todo.v1.CommentService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCommentServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CommentServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	commentServiceMethods := v1.File_proto_todo_v1_comment_proto.Services().ByName("CommentService").Methods()
+	return &commentServiceClient{
+		addComment: connect.NewClient[v1.AddCommentRequest, v1.AddCommentResponse](
+			httpClient,
+			baseURL+CommentServiceAddCommentProcedure,
+			connect.WithSchema(commentServiceMethods.ByName("AddComment")),
+			connect.WithClientOptions(opts...),
+		),
+		updateComment: connect.NewClient[v1.UpdateCommentRequest, v1.UpdateCommentResponse](
+			httpClient,
+			baseURL+CommentServiceUpdateCommentProcedure,
+			connect.WithSchema(commentServiceMethods.ByName("UpdateComment")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteComment: connect.NewClient[v1.DeleteCommentRequest, v1.DeleteCommentResponse](
+			httpClient,
+			baseURL+CommentServiceDeleteCommentProcedure,
+			connect.WithSchema(commentServiceMethods.ByName("DeleteComment")),
+			connect.WithClientOptions(opts...),
+		),
+		listComments: connect.NewClient[v1.ListCommentsRequest, v1.ListCommentsResponse](
+			httpClient,
+			baseURL+CommentServiceListCommentsProcedure,
+			connect.WithSchema(commentServiceMethods.ByName("ListComments")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// commentServiceClient implements CommentServiceClient.
+type commentServiceClient struct {
+	addComment    *connect.Client[v1.AddCommentRequest, v1.AddCommentResponse]
+	updateComment *connect.Client[v1.UpdateCommentRequest, v1.UpdateCommentResponse]
+	deleteComment *connect.Client[v1.DeleteCommentRequest, v1.DeleteCommentResponse]
+	listComments  *connect.Client[v1.ListCommentsRequest, v1.ListCommentsResponse]
+}
+
+// AddComment calls todo.v1.CommentService.AddComment.
+func (c *commentServiceClient) AddComment(ctx context.Context, req *connect.Request[v1.AddCommentRequest]) (*connect.Response[v1.AddCommentResponse], error) {
+	return c.addComment.CallUnary(ctx, req)
+}
+
+// UpdateComment calls todo.v1.CommentService.UpdateComment.
+func (c *commentServiceClient) UpdateComment(ctx context.Context, req *connect.Request[v1.UpdateCommentRequest]) (*connect.Response[v1.UpdateCommentResponse], error) {
+	return c.updateComment.CallUnary(ctx, req)
+}
+
+// DeleteComment calls todo.v1.CommentService.DeleteComment.
+func (c *commentServiceClient) DeleteComment(ctx context.Context, req *connect.Request[v1.DeleteCommentRequest]) (*connect.Response[v1.DeleteCommentResponse], error) {
+	return c.deleteComment.CallUnary(ctx, req)
+}
+
+// ListComments calls todo.v1.CommentService.ListComments.
+func (c *commentServiceClient) ListComments(ctx context.Context, req *connect.Request[v1.ListCommentsRequest]) (*connect.Response[v1.ListCommentsResponse], error) {
+	return c.listComments.CallUnary(ctx, req)
+}
+
+// CommentServiceHandler is an implementation of the todo.v1.CommentService service.
+type CommentServiceHandler interface {
+	AddComment(context.Context, *connect.Request[v1.AddCommentRequest]) (*connect.Response[v1.AddCommentResponse], error)
+	// Only the author, the X-User of the AddComment request, may update or
+	// delete a comment.
+	UpdateComment(context.Context, *connect.Request[v1.UpdateCommentRequest]) (*connect.Response[v1.UpdateCommentResponse], error)
+	DeleteComment(context.Context, *connect.Request[v1.DeleteCommentRequest]) (*connect.Response[v1.DeleteCommentResponse], error)
+	ListComments(context.Context, *connect.Request[v1.ListCommentsRequest]) (*connect.Response[v1.ListCommentsResponse], error)
+}
+
+// NewCommentServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCommentServiceHandler(svc CommentServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	commentServiceMethods := v1.File_proto_todo_v1_comment_proto.Services().ByName("CommentService").Methods()
+	commentServiceAddCommentHandler := connect.NewUnaryHandler(
+		CommentServiceAddCommentProcedure,
+		svc.AddComment,
+		connect.WithSchema(commentServiceMethods.ByName("AddComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	commentServiceUpdateCommentHandler := connect.NewUnaryHandler(
+		CommentServiceUpdateCommentProcedure,
+		svc.UpdateComment,
+		connect.WithSchema(commentServiceMethods.ByName("UpdateComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	commentServiceDeleteCommentHandler := connect.NewUnaryHandler(
+		CommentServiceDeleteCommentProcedure,
+		svc.DeleteComment,
+		connect.WithSchema(commentServiceMethods.ByName("DeleteComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	commentServiceListCommentsHandler := connect.NewUnaryHandler(
+		CommentServiceListCommentsProcedure,
+		svc.ListComments,
+		connect.WithSchema(commentServiceMethods.ByName("ListComments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/todo.v1.CommentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CommentServiceAddCommentProcedure:
+			commentServiceAddCommentHandler.ServeHTTP(w, r)
+		case CommentServiceUpdateCommentProcedure:
+			commentServiceUpdateCommentHandler.ServeHTTP(w, r)
+		case CommentServiceDeleteCommentProcedure:
+			commentServiceDeleteCommentHandler.ServeHTTP(w, r)
+		case CommentServiceListCommentsProcedure:
+			commentServiceListCommentsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCommentServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCommentServiceHandler struct{}
+
+func (UnimplementedCommentServiceHandler) AddComment(context.Context, *connect.Request[v1.AddCommentRequest]) (*connect.Response[v1.AddCommentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.CommentService.AddComment is not implemented"))
+}
+
+func (UnimplementedCommentServiceHandler) UpdateComment(context.Context, *connect.Request[v1.UpdateCommentRequest]) (*connect.Response[v1.UpdateCommentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.CommentService.UpdateComment is not implemented"))
+}
+
+func (UnimplementedCommentServiceHandler) DeleteComment(context.Context, *connect.Request[v1.DeleteCommentRequest]) (*connect.Response[v1.DeleteCommentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.CommentService.DeleteComment is not implemented"))
+}
+
+func (UnimplementedCommentServiceHandler) ListComments(context.Context, *connect.Request[v1.ListCommentsRequest]) (*connect.Response[v1.ListCommentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.CommentService.ListComments is not implemented"))
+}
@@ -45,6 +45,14 @@ const (
 	TodoServiceDeleteTodoProcedure = "/todo.v1.TodoService/DeleteTodo"
 	// TodoServiceGetTodosProcedure is the fully-qualified name of the TodoService's GetTodos RPC.
 	TodoServiceGetTodosProcedure = "/todo.v1.TodoService/GetTodos"
+	// TodoServiceAddDependencyProcedure is the fully-qualified name of the TodoService's AddDependency
+	// RPC.
+	TodoServiceAddDependencyProcedure = "/todo.v1.TodoService/AddDependency"
+	// TodoServiceRemoveDependencyProcedure is the fully-qualified name of the TodoService's
+	// RemoveDependency RPC.
+	TodoServiceRemoveDependencyProcedure = "/todo.v1.TodoService/RemoveDependency"
+	// TodoServiceMoveTodoProcedure is the fully-qualified name of the TodoService's MoveTodo RPC.
+	TodoServiceMoveTodoProcedure = "/todo.v1.TodoService/MoveTodo"
 )
 
 // TodoServiceClient is a client for the todo.v1.TodoService service.
@@ -54,6 +62,9 @@ type TodoServiceClient interface {
 	UpdateTodo(context.Context, *connect.Request[v1.UpdateTodoRequest]) (*connect.Response[v1.UpdateTodoResponse], error)
 	DeleteTodo(context.Context, *connect.Request[v1.DeleteTodoRequest]) (*connect.Response[v1.DeleteTodoResponse], error)
 	GetTodos(context.Context, *connect.Request[v1.GetTodosRequest]) (*connect.Response[v1.GetTodosResponse], error)
+	AddDependency(context.Context, *connect.Request[v1.AddDependencyRequest]) (*connect.Response[v1.AddDependencyResponse], error)
+	RemoveDependency(context.Context, *connect.Request[v1.RemoveDependencyRequest]) (*connect.Response[v1.RemoveDependencyResponse], error)
+	MoveTodo(context.Context, *connect.Request[v1.MoveTodoRequest]) (*connect.Response[v1.MoveTodoResponse], error)
 }
 
 // NewTodoServiceClient constructs a client for the todo.v1.TodoService service. By default, it uses
@@ -97,16 +108,37 @@ func NewTodoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(todoServiceMethods.ByName("GetTodos")),
 			connect.WithClientOptions(opts...),
 		),
+		addDependency: connect.NewClient[v1.AddDependencyRequest, v1.AddDependencyResponse](
+			httpClient,
+			baseURL+TodoServiceAddDependencyProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("AddDependency")),
+			connect.WithClientOptions(opts...),
+		),
+		removeDependency: connect.NewClient[v1.RemoveDependencyRequest, v1.RemoveDependencyResponse](
+			httpClient,
+			baseURL+TodoServiceRemoveDependencyProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("RemoveDependency")),
+			connect.WithClientOptions(opts...),
+		),
+		moveTodo: connect.NewClient[v1.MoveTodoRequest, v1.MoveTodoResponse](
+			httpClient,
+			baseURL+TodoServiceMoveTodoProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("MoveTodo")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // todoServiceClient implements TodoServiceClient.
 type todoServiceClient struct {
-	createTodo *connect.Client[v1.CreateTodoRequest, v1.CreateTodoResponse]
-	getTodo    *connect.Client[v1.GetTodoRequest, v1.GetTodoResponse]
-	updateTodo *connect.Client[v1.UpdateTodoRequest, v1.UpdateTodoResponse]
-	deleteTodo *connect.Client[v1.DeleteTodoRequest, v1.DeleteTodoResponse]
-	getTodos   *connect.Client[v1.GetTodosRequest, v1.GetTodosResponse]
+	createTodo       *connect.Client[v1.CreateTodoRequest, v1.CreateTodoResponse]
+	getTodo          *connect.Client[v1.GetTodoRequest, v1.GetTodoResponse]
+	updateTodo       *connect.Client[v1.UpdateTodoRequest, v1.UpdateTodoResponse]
+	deleteTodo       *connect.Client[v1.DeleteTodoRequest, v1.DeleteTodoResponse]
+	getTodos         *connect.Client[v1.GetTodosRequest, v1.GetTodosResponse]
+	addDependency    *connect.Client[v1.AddDependencyRequest, v1.AddDependencyResponse]
+	removeDependency *connect.Client[v1.RemoveDependencyRequest, v1.RemoveDependencyResponse]
+	moveTodo         *connect.Client[v1.MoveTodoRequest, v1.MoveTodoResponse]
 }
 
 // CreateTodo calls todo.v1.TodoService.CreateTodo.
@@ -134,6 +166,21 @@ func (c *todoServiceClient) GetTodos(ctx context.Context, req *connect.Request[v
 	return c.getTodos.CallUnary(ctx, req)
 }
 
+// AddDependency calls todo.v1.TodoService.AddDependency.
+func (c *todoServiceClient) AddDependency(ctx context.Context, req *connect.Request[v1.AddDependencyRequest]) (*connect.Response[v1.AddDependencyResponse], error) {
+	return c.addDependency.CallUnary(ctx, req)
+}
+
+// RemoveDependency calls todo.v1.TodoService.RemoveDependency.
+func (c *todoServiceClient) RemoveDependency(ctx context.Context, req *connect.Request[v1.RemoveDependencyRequest]) (*connect.Response[v1.RemoveDependencyResponse], error) {
+	return c.removeDependency.CallUnary(ctx, req)
+}
+
+// MoveTodo calls todo.v1.TodoService.MoveTodo.
+func (c *todoServiceClient) MoveTodo(ctx context.Context, req *connect.Request[v1.MoveTodoRequest]) (*connect.Response[v1.MoveTodoResponse], error) {
+	return c.moveTodo.CallUnary(ctx, req)
+}
+
 // TodoServiceHandler is an implementation of the todo.v1.TodoService service.
 type TodoServiceHandler interface {
 	CreateTodo(context.Context, *connect.Request[v1.CreateTodoRequest]) (*connect.Response[v1.CreateTodoResponse], error)
@@ -141,6 +188,9 @@ type TodoServiceHandler interface {
 	UpdateTodo(context.Context, *connect.Request[v1.UpdateTodoRequest]) (*connect.Response[v1.UpdateTodoResponse], error)
 	DeleteTodo(context.Context, *connect.Request[v1.DeleteTodoRequest]) (*connect.Response[v1.DeleteTodoResponse], error)
 	GetTodos(context.Context, *connect.Request[v1.GetTodosRequest]) (*connect.Response[v1.GetTodosResponse], error)
+	AddDependency(context.Context, *connect.Request[v1.AddDependencyRequest]) (*connect.Response[v1.AddDependencyResponse], error)
+	RemoveDependency(context.Context, *connect.Request[v1.RemoveDependencyRequest]) (*connect.Response[v1.RemoveDependencyResponse], error)
+	MoveTodo(context.Context, *connect.Request[v1.MoveTodoRequest]) (*connect.Response[v1.MoveTodoResponse], error)
 }
 
 // NewTodoServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -180,6 +230,24 @@ func NewTodoServiceHandler(svc TodoServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(todoServiceMethods.ByName("GetTodos")),
 		connect.WithHandlerOptions(opts...),
 	)
+	todoServiceAddDependencyHandler := connect.NewUnaryHandler(
+		TodoServiceAddDependencyProcedure,
+		svc.AddDependency,
+		connect.WithSchema(todoServiceMethods.ByName("AddDependency")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceRemoveDependencyHandler := connect.NewUnaryHandler(
+		TodoServiceRemoveDependencyProcedure,
+		svc.RemoveDependency,
+		connect.WithSchema(todoServiceMethods.ByName("RemoveDependency")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceMoveTodoHandler := connect.NewUnaryHandler(
+		TodoServiceMoveTodoProcedure,
+		svc.MoveTodo,
+		connect.WithSchema(todoServiceMethods.ByName("MoveTodo")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/todo.v1.TodoService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TodoServiceCreateTodoProcedure:
@@ -192,6 +260,12 @@ func NewTodoServiceHandler(svc TodoServiceHandler, opts ...connect.HandlerOption
 			todoServiceDeleteTodoHandler.ServeHTTP(w, r)
 		case TodoServiceGetTodosProcedure:
 			todoServiceGetTodosHandler.ServeHTTP(w, r)
+		case TodoServiceAddDependencyProcedure:
+			todoServiceAddDependencyHandler.ServeHTTP(w, r)
+		case TodoServiceRemoveDependencyProcedure:
+			todoServiceRemoveDependencyHandler.ServeHTTP(w, r)
+		case TodoServiceMoveTodoProcedure:
+			todoServiceMoveTodoHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -220,3 +294,15 @@ func (UnimplementedTodoServiceHandler) DeleteTodo(context.Context, *connect.Requ
 func (UnimplementedTodoServiceHandler) GetTodos(context.Context, *connect.Request[v1.GetTodosRequest]) (*connect.Response[v1.GetTodosResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.GetTodos is not implemented"))
 }
+
+func (UnimplementedTodoServiceHandler) AddDependency(context.Context, *connect.Request[v1.AddDependencyRequest]) (*connect.Response[v1.AddDependencyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.AddDependency is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) RemoveDependency(context.Context, *connect.Request[v1.RemoveDependencyRequest]) (*connect.Response[v1.RemoveDependencyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.RemoveDependency is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) MoveTodo(context.Context, *connect.Request[v1.MoveTodoRequest]) (*connect.Response[v1.MoveTodoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.MoveTodo is not implemented"))
+}
@@ -0,0 +1,189 @@
+package apierr
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// Domain is the ErrorInfo domain of the errors returned by this service.
+const Domain = "github.com/kogamitora/todo"
+
+// Reasons reported in ErrorInfo. Clients may switch on them.
+const (
+	ReasonDuplicateKey         = "DUPLICATE_KEY"
+	ReasonDeadlock             = "DEADLOCK"
+	ReasonSerializationFailure = "SERIALIZATION_FAILURE"
+	ReasonLockWaitTimeout      = "LOCK_WAIT_TIMEOUT"
+	ReasonForeignKeyViolation  = "FOREIGN_KEY_VIOLATION"
+	ReasonDatabaseUnavailable  = "DATABASE_UNAVAILABLE"
+	ReasonRateLimited          = "RATE_LIMITED"
+	ReasonQuotaExceeded        = "QUOTA_EXCEEDED"
+	ReasonInternal             = "INTERNAL"
+)
+
+// MySQL server error numbers, see
+// https://dev.mysql.com/doc/mysql-errors/8.0/en/server-error-reference.html
+const (
+	errDupEntry           = 1062
+	errLockWaitTimeout    = 1205
+	errLockDeadlock       = 1213
+	errRowIsReferenced    = 1451
+	errNoReferencedRow    = 1452
+	errRowIsReferencedOld = 1217
+	errNoReferencedRowOld = 1216
+)
+
+// PostgreSQL SQLSTATE codes, see
+// https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pgUniqueViolation      = "23505"
+	pgForeignKeyViolation  = "23503"
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+	pgLockNotAvailable     = "55P03"
+)
+
+// Convert turns an unexpected error, typically from the database, into the
+// error sent to the client. Known MySQL, PostgreSQL and SQLite errors get a
+// code the client can act on; anything else becomes an opaque CodeInternal. The original message is
+// never included, so log err before converting it. Connect errors are
+// returned unchanged.
+func Convert(err error) *connect.Error {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr
+	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.NewError(connect.CodeDeadlineExceeded, errors.New("deadline exceeded"))
+	case errors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, errors.New("request canceled"))
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case errDupEntry:
+			return withInfo(connect.CodeAlreadyExists, ReasonDuplicateKey, "the record already exists")
+		case errLockDeadlock:
+			return withInfo(connect.CodeAborted, ReasonDeadlock, "the transaction was aborted by a concurrent one; retry the request")
+		case errLockWaitTimeout:
+			return withInfo(connect.CodeAborted, ReasonLockWaitTimeout, "timed out waiting for a lock; retry the request")
+		case errNoReferencedRow, errNoReferencedRowOld, errRowIsReferenced, errRowIsReferencedOld:
+			return withInfo(connect.CodeFailedPrecondition, ReasonForeignKeyViolation, "the change conflicts with a related record")
+		}
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case pgUniqueViolation:
+			return withInfo(connect.CodeAlreadyExists, ReasonDuplicateKey, "the record already exists")
+		case pgForeignKeyViolation:
+			return withInfo(connect.CodeFailedPrecondition, ReasonForeignKeyViolation, "the change conflicts with a related record")
+		case pgSerializationFailure:
+			return withInfo(connect.CodeAborted, ReasonSerializationFailure, "the transaction was aborted by a concurrent one; retry the request")
+		case pgDeadlockDetected:
+			return withInfo(connect.CodeAborted, ReasonDeadlock, "the transaction was aborted by a concurrent one; retry the request")
+		case pgLockNotAvailable:
+			return withInfo(connect.CodeAborted, ReasonLockWaitTimeout, "timed out waiting for a lock; retry the request")
+		}
+	}
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		// extended result codes refine the primary code in the low byte
+		switch code := sqliteErr.Code(); {
+		case code == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY, code == sqlite3.SQLITE_CONSTRAINT_UNIQUE:
+			return withInfo(connect.CodeAlreadyExists, ReasonDuplicateKey, "the record already exists")
+		case code == sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+			return withInfo(connect.CodeFailedPrecondition, ReasonForeignKeyViolation, "the change conflicts with a related record")
+		case code&0xff == sqlite3.SQLITE_BUSY, code&0xff == sqlite3.SQLITE_LOCKED:
+			return withInfo(connect.CodeAborted, ReasonLockWaitTimeout, "timed out waiting for a lock; retry the request")
+		}
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, driver.ErrBadConn) || errors.Is(err, mysql.ErrInvalidConn) {
+		return withInfo(connect.CodeUnavailable, ReasonDatabaseUnavailable, "the database is unavailable; retry later")
+	}
+	return withInfo(connect.CodeInternal, ReasonInternal, "internal error")
+}
+
+// InvalidField returns a CodeInvalidArgument error with a BadRequest detail
+// naming the offending request field.
+func InvalidField(field, description string) *connect.Error {
+	err := connect.NewError(connect.CodeInvalidArgument, errors.New(description))
+	addDetail(err, &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: description},
+		},
+	})
+	return err
+}
+
+// InvalidFields returns a CodeInvalidArgument error with a BadRequest detail
+// listing every violation. The message names the fields, since descriptions
+// produced by validators usually do not.
+func InvalidFields(violations []*errdetails.BadRequest_FieldViolation) *connect.Error {
+	msgs := make([]string, len(violations))
+	for i, v := range violations {
+		msgs[i] = v.Field + ": " + v.Description
+	}
+	err := connect.NewError(connect.CodeInvalidArgument, errors.New(strings.Join(msgs, "; ")))
+	addDetail(err, &errdetails.BadRequest{FieldViolations: violations})
+	return err
+}
+
+// Invalidf returns a CodeInvalidArgument error for the named field with a
+// formatted description.
+func Invalidf(field, format string, args ...any) *connect.Error {
+	return InvalidField(field, fmt.Sprintf(format, args...))
+}
+
+// RateLimited returns a CodeResourceExhausted error telling the client when
+// to retry, both as a RetryInfo detail and as a Retry-After header in seconds.
+func RateLimited(retryAfter time.Duration) *connect.Error {
+	err := withInfo(connect.CodeResourceExhausted, ReasonRateLimited,
+		fmt.Sprintf("rate limit exceeded; retry in %s", retryAfter.Round(time.Millisecond)))
+	addDetail(err, &errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+	err.Meta().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	return err
+}
+
+// QuotaExceeded returns a CodeResourceExhausted error with a QuotaFailure
+// detail. Retrying does not help until the subject frees some quota.
+func QuotaExceeded(subject, description string) *connect.Error {
+	err := withInfo(connect.CodeResourceExhausted, ReasonQuotaExceeded, description)
+	addDetail(err, &errdetails.QuotaFailure{
+		Violations: []*errdetails.QuotaFailure_Violation{
+			{Subject: subject, Description: description},
+		},
+	})
+	return err
+}
+
+func withInfo(code connect.Code, reason, message string) *connect.Error {
+	err := connect.NewError(code, errors.New(message))
+	addDetail(err, &errdetails.ErrorInfo{Reason: reason, Domain: Domain})
+	return err
+}
+
+func addDetail(err *connect.Error, msg proto.Message) {
+	// NewErrorDetail only fails for messages that cannot be marshaled
+	if detail, detailErr := connect.NewErrorDetail(msg); detailErr == nil {
+		err.AddDetail(detail)
+	}
+}
@@ -0,0 +1,160 @@
+package apierr
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		code   connect.Code
+		reason string
+	}{
+		{"duplicate key", &mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'x' for key 'PRIMARY'"}, connect.CodeAlreadyExists, ReasonDuplicateKey},
+		{"deadlock", fmt.Errorf("models: unable to update: %w", &mysql.MySQLError{Number: 1213}), connect.CodeAborted, ReasonDeadlock},
+		{"lock wait timeout", &mysql.MySQLError{Number: 1205}, connect.CodeAborted, ReasonLockWaitTimeout},
+		{"foreign key", &mysql.MySQLError{Number: 1452}, connect.CodeFailedPrecondition, ReasonForeignKeyViolation},
+		{"postgres unique", &pgconn.PgError{Code: "23505"}, connect.CodeAlreadyExists, ReasonDuplicateKey},
+		{"postgres foreign key", fmt.Errorf("pgmodels: unable to insert: %w", &pgconn.PgError{Code: "23503"}), connect.CodeFailedPrecondition, ReasonForeignKeyViolation},
+		{"postgres serialization", &pgconn.PgError{Code: "40001"}, connect.CodeAborted, ReasonSerializationFailure},
+		{"postgres deadlock", &pgconn.PgError{Code: "40P01"}, connect.CodeAborted, ReasonDeadlock},
+		{"postgres lock timeout", &pgconn.PgError{Code: "55P03"}, connect.CodeAborted, ReasonLockWaitTimeout},
+		{"postgres other", &pgconn.PgError{Code: "42P01", Message: `relation "todos" does not exist`}, connect.CodeInternal, ReasonInternal},
+		{"bad connection", mysql.ErrInvalidConn, connect.CodeUnavailable, ReasonDatabaseUnavailable},
+		{"unknown", errors.New("Table 'todo.todos' doesn't exist"), connect.CodeInternal, ReasonInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Convert(tt.err)
+			if err.Code() != tt.code {
+				t.Fatalf("code = %v, want %v", err.Code(), tt.code)
+			}
+			if strings.Contains(err.Message(), "todos") || strings.Contains(err.Message(), "Duplicate") {
+				t.Errorf("message leaks the database error: %q", err.Message())
+			}
+			info := detail[*errdetails.ErrorInfo](t, err)
+			if info.Reason != tt.reason || info.Domain != Domain {
+				t.Errorf("ErrorInfo = %v, want reason %s", info, tt.reason)
+			}
+		})
+	}
+
+	notFound := connect.NewError(connect.CodeNotFound, errors.New("todo not found"))
+	if got := Convert(notFound); got != notFound {
+		t.Errorf("Convert changed a Connect error: %v", got)
+	}
+}
+
+// TestConvertSQLite converts the errors of a real SQLite database, since
+// the driver's errors cannot be built by hand.
+func TestConvertSQLite(t *testing.T) {
+	ctx := context.Background()
+	dsn := "file:" + filepath.Join(t.TempDir(), "test.db") + "?_pragma=foreign_keys(1)&_pragma=busy_timeout(0)"
+	open := func() *sql.DB {
+		db, err := sql.Open("sqlite", dsn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { db.Close() })
+		return db
+	}
+	db := open()
+	for _, q := range []string{
+		"CREATE TABLE todos (id INTEGER PRIMARY KEY, title TEXT UNIQUE)",
+		"CREATE TABLE comments (id INTEGER PRIMARY KEY, todo_id INTEGER NOT NULL REFERENCES todos (id))",
+		"INSERT INTO todos (id, title) VALUES (1, 'a')",
+	} {
+		if _, err := db.ExecContext(ctx, q); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// another connection holds the write lock
+	conn, err := open().Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	lock := func() {
+		if _, err := conn.ExecContext(ctx, "BEGIN IMMEDIATE"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	unlock := func() {
+		if _, err := conn.ExecContext(ctx, "ROLLBACK"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		query  string
+		locked bool
+		code   connect.Code
+		reason string
+	}{
+		{"primary key", "INSERT INTO todos (id, title) VALUES (1, 'b')", false, connect.CodeAlreadyExists, ReasonDuplicateKey},
+		{"unique", "INSERT INTO todos (title) VALUES ('a')", false, connect.CodeAlreadyExists, ReasonDuplicateKey},
+		{"foreign key", "INSERT INTO comments (todo_id) VALUES (2)", false, connect.CodeFailedPrecondition, ReasonForeignKeyViolation},
+		{"busy", "INSERT INTO todos (title) VALUES ('b')", true, connect.CodeAborted, ReasonLockWaitTimeout},
+		{"unknown", "SELECT * FROM missing", false, connect.CodeInternal, ReasonInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.locked {
+				lock()
+				defer unlock()
+			}
+			_, dbErr := db.ExecContext(ctx, tt.query)
+			if dbErr == nil {
+				t.Fatal("query succeeded")
+			}
+			err := Convert(fmt.Errorf("sqlite: %w", dbErr))
+			if err.Code() != tt.code {
+				t.Fatalf("code = %v, want %v (%v)", err.Code(), tt.code, dbErr)
+			}
+			if info := detail[*errdetails.ErrorInfo](t, err); info.Reason != tt.reason {
+				t.Errorf("reason = %s, want %s", info.Reason, tt.reason)
+			}
+		})
+	}
+}
+
+func TestInvalidField(t *testing.T) {
+	err := Invalidf("limit", "limit must be between 1 and %d", 100)
+	if err.Code() != connect.CodeInvalidArgument {
+		t.Fatalf("code = %v, want invalid_argument", err.Code())
+	}
+	br := detail[*errdetails.BadRequest](t, err)
+	if len(br.FieldViolations) != 1 || br.FieldViolations[0].Field != "limit" || br.FieldViolations[0].Description != "limit must be between 1 and 100" {
+		t.Errorf("unexpected field violations: %v", br.FieldViolations)
+	}
+}
+
+func detail[T any](t *testing.T, err *connect.Error) T {
+	t.Helper()
+	for _, d := range err.Details() {
+		value, valueErr := d.Value()
+		if valueErr != nil {
+			t.Fatal(valueErr)
+		}
+		if v, ok := value.(T); ok {
+			return v
+		}
+	}
+	var zero T
+	t.Fatalf("error has no %T detail", zero)
+	return zero
+}
@@ -0,0 +1,54 @@
+package apierr
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"connectrpc.com/connect"
+
+	"github.com/kogamitora/todo/internal/logging"
+)
+
+// NewInterceptor converts errors that handlers return without wrapping them
+// in a Connect error, so that their messages never reach the client. The
+// original error is logged with the request-scoped logger.
+func NewInterceptor(logger *slog.Logger) connect.Interceptor {
+	return &interceptor{logger: logger}
+}
+
+type interceptor struct {
+	logger *slog.Logger
+}
+
+func (i *interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		res, err := next(ctx, req)
+		if err != nil && !req.Spec().IsClient {
+			return nil, i.convert(ctx, err)
+		}
+		return res, err
+	}
+}
+
+func (i *interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := next(ctx, conn); err != nil {
+			return i.convert(ctx, err)
+		}
+		return nil
+	}
+}
+
+func (i *interceptor) convert(ctx context.Context, err error) error {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return err
+	}
+	logging.FromContext(ctx, i.logger).ErrorContext(ctx, "unhandled error", "error", err)
+	return Convert(err)
+}
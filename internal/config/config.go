@@ -2,48 +2,192 @@ package config
 
 import (
 	"fmt"
-	"os"
+	"net"
+	"net/url"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 )
 
 type Config struct {
 	Server   ServerConfig   `json:"server"`
 	Database DatabaseConfig `json:"database"`
+	Workflow WorkflowConfig `json:"workflow"`
+	Storage  StorageConfig  `json:"storage"`
+	Tracing  TracingConfig  `json:"tracing"`
+	Log      LogConfig      `json:"log"`
+	Limits   LimitsConfig   `json:"limits"`
+	Features FeaturesConfig `json:"features" reload:"true"`
 }
 
 type ServerConfig struct {
 	Port string `json:"port"`
 	Host string `json:"host"`
+	// ShutdownTimeout bounds how long in-flight requests may run after SIGTERM.
+	ShutdownTimeout time.Duration `json:"shutdown_timeout"`
+	// TLS is disabled, and the server speaks h2c, unless a certificate is set.
+	TLS  TLSConfig  `json:"tls"`
+	CORS CORSConfig `json:"cors" reload:"true"`
 }
 
+// CORSConfig lets browser applications on other origins call the API.
+type CORSConfig struct {
+	// AllowedOrigins such as "https://app.example.com", or "*" for any
+	// origin. Empty disables CORS.
+	AllowedOrigins []string `json:"allowed_origins"`
+}
+
+// TLSConfig holds the server certificate and, for mutual TLS, the CA that
+// signs client certificates.
+type TLSConfig struct {
+	CertFile     string `json:"cert_file"`
+	KeyFile      string `json:"key_file"`
+	ClientCAFile string `json:"client_ca_file"`
+}
+
+// Enabled reports whether the server should serve TLS.
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != ""
+}
+
+// Database drivers.
+const (
+	DriverMySQL    = "mysql"
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+	// DriverMemory keeps everything in memory until the server exits.
+	DriverMemory = "memory"
+)
+
 type DatabaseConfig struct {
+	// Driver selects the backend: DriverMySQL, DriverPostgres, DriverSQLite
+	// or DriverMemory.
+	Driver   string `json:"driver"`
 	Host     string `json:"host"`
 	Port     string `json:"port"`
 	User     string `json:"user"`
-	Password string `json:"password"`
+	Password string `json:"password" secret:"true"`
 	Database string `json:"database"`
-	DSN      string `json:"dsn"`
+	DSN      string `json:"dsn" secret:"true"`
+	// Path is the SQLite database file, created if missing.
+	Path string `json:"path"`
+	// MigrationsDir holds the golang-migrate files, replacing those built
+	// into the binary when set. Readiness requires the schema to be at the
+	// latest version.
+	MigrationsDir string `json:"migrations_dir"`
+	// AutoMigrate applies pending migrations on startup.
+	AutoMigrate bool           `json:"auto_migrate"`
+	Pool        PoolConfig     `json:"pool"`
+	Timeouts    TimeoutsConfig `json:"timeouts"`
+	Replicas    ReplicasConfig `json:"replicas"`
 }
 
-// Load reads configuration from environment variables
-func Load() (*Config, error) {
-	config := &Config{
-		Server: ServerConfig{
-			Host: getEnv("SERVER_HOST", ""),
-			Port: getEnv("SERVER_PORT", ""),
-		},
-		Database: DatabaseConfig{
-			Host:     getEnv("DB_HOST", ""),
-			Port:     getEnv("DB_PORT", ""),
-			User:     getEnv("DB_USER", ""),
-			Password: getEnv("DB_PASSWORD", ""),
-			Database: getEnv("DB_NAME", ""),
-			DSN:      getEnv("DB_DSN", ""),
-		},
-	}
+// ReplicasConfig lists read-only copies of a MySQL or PostgreSQL database
+// that serve GetTodos and GetTodo. The replicas use the pool and timeout
+// settings of the primary.
+type ReplicasConfig struct {
+	// DSNs are the connection strings of the replicas. Empty sends every
+	// query to the primary.
+	DSNs []string `json:"dsns" secret:"true"`
+	// StickyWindow is how long a client reads from the primary after a call
+	// that changed data, so that it sees its own writes despite the
+	// replication lag. Each server remembers only the writes it served, so
+	// with several servers the load balancer must keep a client on one of
+	// them for this to hold.
+	StickyWindow time.Duration `json:"sticky_window"`
+	// HealthInterval is how often the replicas are pinged. A replica that
+	// fails takes no reads until it answers again.
+	HealthInterval time.Duration `json:"health_interval"`
+}
+
+// PoolConfig sizes the database connection pool. Zero durations mean
+// connections are never closed for their age.
+type PoolConfig struct {
+	MaxOpenConns    int           `json:"max_open_conns"`
+	MaxIdleConns    int           `json:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `json:"conn_max_lifetime"`
+	ConnMaxIdleTime time.Duration `json:"conn_max_idle_time"`
+}
+
+// TimeoutsConfig bounds the time spent waiting for the database. Zero
+// means no limit.
+type TimeoutsConfig struct {
+	// Connect, Read and Write are the dial and network I/O timeouts of the
+	// driver. Parameters set in DSN take precedence.
+	Connect time.Duration `json:"connect"`
+	Read    time.Duration `json:"read"`
+	Write   time.Duration `json:"write"`
+	// Query caps the queries of one RPC. A shorter deadline sent by the
+	// client applies instead.
+	Query time.Duration `json:"query"`
+	// Startup is how long the server retries connecting before giving up,
+	// so that it can start before MySQL is ready.
+	Startup time.Duration `json:"startup"`
+}
+
+// WorkflowConfig controls which status changes UpdateTodo accepts.
+type WorkflowConfig struct {
+	// StatusTransitions is a list of rules such as
+	// "incomplete:in_progress|completed;in_progress:completed".
+	// An empty value selects the built-in workflow.
+	StatusTransitions string `json:"status_transitions"`
+}
+
+// StorageConfig selects where attachment contents are stored.
+type StorageConfig struct {
+	Backend           string   `json:"backend"` // "local" or "s3"
+	LocalDir          string   `json:"local_dir"`
+	MaxAttachmentSize int64    `json:"max_attachment_size"` // in bytes
+	S3                S3Config `json:"s3"`
+}
 
-	return config, nil
+// S3Config holds the settings of an S3-compatible service such as MinIO.
+type S3Config struct {
+	Endpoint  string `json:"endpoint"`
+	Bucket    string `json:"bucket"`
+	Region    string `json:"region"`
+	AccessKey string `json:"access_key"`
+	SecretKey string `json:"secret_key" secret:"true"`
+	UseSSL    bool   `json:"use_ssl"`
+}
+
+// TracingConfig selects where OpenTelemetry spans are exported.
+type TracingConfig struct {
+	Exporter     string `json:"exporter"`      // "none", "stdout" or "otlp"
+	OTLPEndpoint string `json:"otlp_endpoint"` // host:port of an OTLP/HTTP collector
+	OTLPInsecure bool   `json:"otlp_insecure"`
+	// SampleRatio is the fraction of new traces to record. Traces started
+	// by the caller follow the caller's sampling decision.
+	SampleRatio float64 `json:"sample_ratio"`
+}
+
+// LogConfig controls the server's log output.
+type LogConfig struct {
+	Level  string `json:"level" reload:"true"` // "debug", "info", "warn" or "error"
+	Format string `json:"format"`              // "json" or "text"
+}
+
+// LimitsConfig protects the server, and the database pool behind it, from
+// clients that send too much.
+type LimitsConfig struct {
+	// RequestsPerSecond and Burst size the token bucket of each client,
+	// keyed by client IP. Zero RequestsPerSecond disables it.
+	RequestsPerSecond float64 `json:"requests_per_second" reload:"true"`
+	Burst             int     `json:"burst" reload:"true"`
+	// TodosPerUser caps the todos a user may own that are not deleted,
+	// whatever their status; zero means no cap. With a cap, creating a todo
+	// needs the X-User header.
+	TodosPerUser int `json:"todos_per_user"`
+}
+
+// FeaturesConfig turns optional endpoints on and off.
+type FeaturesConfig struct {
+	// Reflection exposes the gRPC reflection services for grpcurl and
+	// Buf Studio. Disable it in production.
+	Reflection bool `json:"reflection"`
+	// Metrics serves Prometheus metrics on /metrics.
+	Metrics bool `json:"metrics"`
 }
 
 // GetDSN builds the database connection string.
@@ -51,6 +195,19 @@ func (c *Config) GetDSN() string {
 	if c.Database.DSN != "" {
 		return c.Database.DSN
 	}
+	if c.Database.Driver == DriverSQLite {
+		return c.Database.Path
+	}
+	if c.Database.Driver == DriverPostgres {
+		u := url.URL{
+			Scheme:   "postgres",
+			User:     url.UserPassword(c.Database.User, c.Database.Password),
+			Host:     net.JoinHostPort(c.Database.Host, c.Database.Port),
+			Path:     "/" + c.Database.Database,
+			RawQuery: "sslmode=disable",
+		}
+		return u.String()
+	}
 
 	return fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true",
 		c.Database.User,
@@ -77,69 +234,145 @@ func (c *Config) Validate() error {
 	if _, err := strconv.Atoi(c.Server.Port); err != nil {
 		return fmt.Errorf("invalid SERVER_PORT: %s", c.Server.Port)
 	}
+	if c.Server.ShutdownTimeout <= 0 {
+		return fmt.Errorf("SHUTDOWN_TIMEOUT must be positive")
+	}
+	if (c.Server.TLS.CertFile == "") != (c.Server.TLS.KeyFile == "") {
+		return fmt.Errorf("TLS_CERT_FILE and TLS_KEY_FILE must be set together")
+	}
+	if c.Server.TLS.ClientCAFile != "" && !c.Server.TLS.Enabled() {
+		return fmt.Errorf("TLS_CLIENT_CA_FILE requires TLS_CERT_FILE")
+	}
 
-	// If DSN is not provided, validate individual DB components.
-	if c.Database.DSN == "" {
-		if c.Database.Host == "" {
-			return fmt.Errorf("DB_HOST is required")
+	switch c.Database.Driver {
+	case DriverMySQL, DriverPostgres:
+		if err := c.validateServer(); err != nil {
+			return err
 		}
-		if c.Database.Port == "" {
-			return fmt.Errorf("DB_PORT is required")
+	case DriverSQLite:
+		if c.Database.DSN == "" && c.Database.Path == "" {
+			return fmt.Errorf("DB_PATH is required")
 		}
-		if c.Database.User == "" {
-			return fmt.Errorf("DB_USER is required")
+	case DriverMemory:
+	default:
+		return fmt.Errorf("invalid DB_DRIVER: %s", c.Database.Driver)
+	}
+	if err := c.validateReplicas(); err != nil {
+		return err
+	}
+
+	switch c.Storage.Backend {
+	case "local":
+		if c.Storage.LocalDir == "" {
+			return fmt.Errorf("STORAGE_LOCAL_DIR is required")
 		}
-		if c.Database.Password == "" {
-			return fmt.Errorf("DB_PASSWORD is required")
+	case "s3":
+		if c.Storage.S3.Endpoint == "" {
+			return fmt.Errorf("S3_ENDPOINT is required")
 		}
-		if c.Database.Database == "" {
-			return fmt.Errorf("DB_NAME is required")
+		if c.Storage.S3.Bucket == "" {
+			return fmt.Errorf("S3_BUCKET is required")
 		}
+	default:
+		return fmt.Errorf("invalid STORAGE_BACKEND: %s", c.Storage.Backend)
 	}
-
-	return nil
-}
-
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+	if c.Storage.MaxAttachmentSize <= 0 {
+		return fmt.Errorf("ATTACHMENT_MAX_SIZE must be positive")
 	}
-	return defaultValue
-}
 
-func LoadFromFile(filename string) error {
-	if _, err := os.Stat(filename); os.IsNotExist(err) {
-		return nil
+	switch c.Tracing.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		if c.Tracing.OTLPEndpoint == "" {
+			return fmt.Errorf("OTLP_ENDPOINT is required")
+		}
+	default:
+		return fmt.Errorf("invalid TRACING_EXPORTER: %s", c.Tracing.Exporter)
 	}
-
-	content, err := os.ReadFile(filename)
-	if err != nil {
-		return fmt.Errorf("failed to read env file: %w", err)
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		return fmt.Errorf("TRACING_SAMPLE_RATIO must be between 0 and 1")
 	}
 
-	lines := strings.Split(string(content), "\n")
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line == "" || strings.HasPrefix(line, "#") {
-			continue
-		}
+	switch strings.ToLower(c.Log.Level) {
+	case "debug", "info", "warn", "error":
+	default:
+		return fmt.Errorf("invalid LOG_LEVEL: %s", c.Log.Level)
+	}
+	switch c.Log.Format {
+	case "json", "text":
+	default:
+		return fmt.Errorf("invalid LOG_FORMAT: %s", c.Log.Format)
+	}
 
-		parts := strings.SplitN(line, "=", 2)
-		if len(parts) != 2 {
-			continue
-		}
+	if c.Database.Pool.MaxOpenConns < 1 {
+		return fmt.Errorf("DB_MAX_OPEN_CONNS must be at least 1")
+	}
+	if c.Database.Pool.MaxIdleConns < 0 || c.Database.Pool.MaxIdleConns > c.Database.Pool.MaxOpenConns {
+		return fmt.Errorf("DB_MAX_IDLE_CONNS must be between 0 and DB_MAX_OPEN_CONNS")
+	}
+	if c.Database.Pool.ConnMaxLifetime < 0 || c.Database.Pool.ConnMaxIdleTime < 0 {
+		return fmt.Errorf("DB_CONN_MAX_LIFETIME and DB_CONN_MAX_IDLE_TIME must not be negative")
+	}
+	t := c.Database.Timeouts
+	if t.Connect < 0 || t.Read < 0 || t.Write < 0 || t.Query < 0 || t.Startup < 0 {
+		return fmt.Errorf("DB_CONNECT_TIMEOUT, DB_READ_TIMEOUT, DB_WRITE_TIMEOUT, DB_QUERY_TIMEOUT and DB_STARTUP_TIMEOUT must not be negative")
+	}
 
-		key := strings.TrimSpace(parts[0])
-		value := strings.TrimSpace(parts[1])
+	if c.Limits.RequestsPerSecond < 0 {
+		return fmt.Errorf("RATE_LIMIT_RPS must not be negative")
+	}
+	if c.Limits.RequestsPerSecond > 0 && c.Limits.Burst < 1 {
+		return fmt.Errorf("RATE_LIMIT_BURST must be at least 1")
+	}
+	if c.Limits.TodosPerUser < 0 {
+		return fmt.Errorf("TODO_QUOTA_PER_USER must not be negative")
+	}
 
-		// Remove surrounding quotes if they exist
-		if (strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`)) ||
-			(strings.HasPrefix(value, `'`) && strings.HasSuffix(value, `'`)) {
-			value = value[1 : len(value)-1]
-		}
+	return nil
+}
 
-		os.Setenv(key, value)
+// validateReplicas checks the read replicas, which only the server drivers
+// support.
+func (c *Config) validateReplicas() error {
+	r := c.Database.Replicas
+	if len(r.DSNs) == 0 {
+		return nil
+	}
+	if c.Database.Driver != DriverMySQL && c.Database.Driver != DriverPostgres {
+		return fmt.Errorf("DB_REPLICA_DSNS requires DB_DRIVER=mysql or postgres")
 	}
+	if slices.Contains(r.DSNs, "") {
+		return fmt.Errorf("DB_REPLICA_DSNS must not contain an empty DSN")
+	}
+	if r.StickyWindow < 0 {
+		return fmt.Errorf("DB_REPLICA_STICKY_WINDOW must not be negative")
+	}
+	if r.HealthInterval <= 0 {
+		return fmt.Errorf("DB_REPLICA_HEALTH_INTERVAL must be positive")
+	}
+	return nil
+}
 
+// validateServer checks the connection settings of a MySQL or PostgreSQL
+// server when no DSN is provided.
+func (c *Config) validateServer() error {
+	if c.Database.DSN != "" {
+		return nil
+	}
+	if c.Database.Host == "" {
+		return fmt.Errorf("DB_HOST is required")
+	}
+	if c.Database.Port == "" {
+		return fmt.Errorf("DB_PORT is required")
+	}
+	if c.Database.User == "" {
+		return fmt.Errorf("DB_USER is required")
+	}
+	if c.Database.Password == "" {
+		return fmt.Errorf("DB_PASSWORD is required")
+	}
+	if c.Database.Database == "" {
+		return fmt.Errorf("DB_NAME is required")
+	}
 	return nil
 }
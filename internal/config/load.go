@@ -0,0 +1,202 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// setting ties a config key to the environment variable and, optionally,
+// the command-line flag that set it.
+type setting struct {
+	key   string
+	env   string
+	value any
+	flag  string
+	usage string
+}
+
+// settings lists every key with its default. The environment variable
+// names predate config files and are kept for existing deployments.
+var settings = []setting{
+	{key: "server.host", env: "SERVER_HOST", value: "", flag: "host", usage: "address to listen on"},
+	{key: "server.port", env: "SERVER_PORT", value: "", flag: "port", usage: "port to listen on"},
+	{key: "server.shutdown_timeout", env: "SHUTDOWN_TIMEOUT", value: "30s", flag: "shutdown-timeout", usage: "how long in-flight requests may run after SIGTERM"},
+	{key: "server.tls.cert_file", env: "TLS_CERT_FILE", value: "", flag: "tls-cert", usage: "TLS certificate; serves h2c when empty"},
+	{key: "server.tls.key_file", env: "TLS_KEY_FILE", value: "", flag: "tls-key", usage: "private key of --tls-cert"},
+	{key: "server.tls.client_ca_file", env: "TLS_CLIENT_CA_FILE", value: "", flag: "tls-client-ca", usage: "CA that client certificates must be signed by; RPCs require one (mutual TLS)"},
+	{key: "server.cors.allowed_origins", env: "CORS_ALLOWED_ORIGINS", value: []string{}},
+
+	{key: "database.driver", env: "DB_DRIVER", value: "mysql", flag: "db-driver", usage: "database backend: mysql, postgres, sqlite or memory"},
+	{key: "database.host", env: "DB_HOST", value: ""},
+	{key: "database.port", env: "DB_PORT", value: ""},
+	{key: "database.user", env: "DB_USER", value: ""},
+	{key: "database.password", env: "DB_PASSWORD", value: ""},
+	{key: "database.database", env: "DB_NAME", value: ""},
+	{key: "database.dsn", env: "DB_DSN", value: ""},
+	{key: "database.path", env: "DB_PATH", value: "todo.db", flag: "db-path", usage: "SQLite database file"},
+	{key: "database.migrations_dir", env: "MIGRATIONS_DIR", value: "", flag: "migrations-dir", usage: "directory of the golang-migrate files; empty for those built in"},
+	{key: "database.auto_migrate", env: "AUTO_MIGRATE", value: false, flag: "auto-migrate", usage: "apply pending migrations on startup"},
+	{key: "database.pool.max_open_conns", env: "DB_MAX_OPEN_CONNS", value: 10},
+	{key: "database.pool.max_idle_conns", env: "DB_MAX_IDLE_CONNS", value: 10},
+	{key: "database.pool.conn_max_lifetime", env: "DB_CONN_MAX_LIFETIME", value: "3m"},
+	{key: "database.pool.conn_max_idle_time", env: "DB_CONN_MAX_IDLE_TIME", value: "0s"},
+	{key: "database.timeouts.connect", env: "DB_CONNECT_TIMEOUT", value: "5s"},
+	{key: "database.timeouts.read", env: "DB_READ_TIMEOUT", value: "30s"},
+	{key: "database.timeouts.write", env: "DB_WRITE_TIMEOUT", value: "30s"},
+	{key: "database.timeouts.query", env: "DB_QUERY_TIMEOUT", value: "10s"},
+	{key: "database.timeouts.startup", env: "DB_STARTUP_TIMEOUT", value: "30s"},
+	{key: "database.replicas.dsns", env: "DB_REPLICA_DSNS", value: []string{}},
+	{key: "database.replicas.sticky_window", env: "DB_REPLICA_STICKY_WINDOW", value: "5s"},
+	{key: "database.replicas.health_interval", env: "DB_REPLICA_HEALTH_INTERVAL", value: "5s"},
+
+	{key: "workflow.status_transitions", env: "STATUS_TRANSITIONS", value: ""},
+
+	{key: "storage.backend", env: "STORAGE_BACKEND", value: "local"},
+	{key: "storage.local_dir", env: "STORAGE_LOCAL_DIR", value: "./data/attachments"},
+	{key: "storage.max_attachment_size", env: "ATTACHMENT_MAX_SIZE", value: 10485760},
+	{key: "storage.s3.endpoint", env: "S3_ENDPOINT", value: ""},
+	{key: "storage.s3.bucket", env: "S3_BUCKET", value: ""},
+	{key: "storage.s3.region", env: "S3_REGION", value: ""},
+	{key: "storage.s3.access_key", env: "S3_ACCESS_KEY", value: ""},
+	{key: "storage.s3.secret_key", env: "S3_SECRET_KEY", value: ""},
+	{key: "storage.s3.use_ssl", env: "S3_USE_SSL", value: false},
+
+	{key: "tracing.exporter", env: "TRACING_EXPORTER", value: "none"},
+	{key: "tracing.otlp_endpoint", env: "OTLP_ENDPOINT", value: "localhost:4318"},
+	{key: "tracing.otlp_insecure", env: "OTLP_INSECURE", value: true},
+	{key: "tracing.sample_ratio", env: "TRACING_SAMPLE_RATIO", value: 1.0},
+
+	{key: "log.level", env: "LOG_LEVEL", value: "info", flag: "log-level", usage: "debug, info, warn or error"},
+	{key: "log.format", env: "LOG_FORMAT", value: "json", flag: "log-format", usage: "json or text"},
+
+	{key: "limits.requests_per_second", env: "RATE_LIMIT_RPS", value: 20.0},
+	{key: "limits.burst", env: "RATE_LIMIT_BURST", value: 40},
+	{key: "limits.todos_per_user", env: "TODO_QUOTA_PER_USER", value: 0},
+
+	{key: "features.reflection", env: "GRPC_REFLECTION", value: true, flag: "reflection", usage: "serve gRPC reflection"},
+	{key: "features.metrics", env: "METRICS_ENABLED", value: true, flag: "metrics", usage: "serve Prometheus metrics on /metrics"},
+}
+
+// ConfigFlag names the flag, and ConfigFileEnv the environment variable,
+// that select a YAML or TOML config file.
+const (
+	ConfigFlag    = "config"
+	ConfigFileEnv = "CONFIG_FILE"
+)
+
+// AddFlags registers --config and the flags that override single settings.
+func AddFlags(fs *pflag.FlagSet) {
+	fs.String(ConfigFlag, "", "YAML or TOML config file (env "+ConfigFileEnv+")")
+	for _, s := range settings {
+		if s.flag == "" {
+			continue
+		}
+		usage := fmt.Sprintf("%s (env %s)", s.usage, s.env)
+		switch v := s.value.(type) {
+		case bool:
+			fs.Bool(s.flag, v, usage)
+		default:
+			fs.String(s.flag, fmt.Sprint(v), usage)
+		}
+	}
+}
+
+// Load builds the configuration from, in increasing precedence, the
+// defaults, the config file, the environment and the flags of fs that were
+// set. fs may be nil, and need only contain the flags added by AddFlags.
+func Load(fs *pflag.FlagSet) (*Config, error) {
+	v := viper.New()
+	for _, s := range settings {
+		v.SetDefault(s.key, s.value)
+		if err := v.BindEnv(s.key, s.env); err != nil {
+			return nil, err
+		}
+		if fs == nil || s.flag == "" {
+			continue
+		}
+		if f := fs.Lookup(s.flag); f != nil {
+			if err := v.BindPFlag(s.key, f); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if file := File(fs); file != "" {
+		v.SetConfigFile(file)
+		if err := v.ReadInConfig(); err != nil {
+			return nil, fmt.Errorf("failed to read config file: %w", err)
+		}
+	}
+
+	config := &Config{}
+	err := v.Unmarshal(config, func(dc *mapstructure.DecoderConfig) {
+		dc.TagName = "json"
+		// a key in the file that no field reads is most likely a typo
+		dc.ErrorUnused = true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	return config, nil
+}
+
+// File returns the config file selected by --config or CONFIG_FILE, or ""
+// if there is none.
+func File(fs *pflag.FlagSet) string {
+	if fs != nil {
+		if f := fs.Lookup(ConfigFlag); f != nil && f.Changed {
+			return f.Value.String()
+		}
+	}
+	return os.Getenv(ConfigFileEnv)
+}
+
+// fromFile records the variables LoadFromFile set, so that loading the
+// file again replaces them. Variables of the process environment are never
+// in it, so they keep their precedence on every reload.
+var fromFile = struct {
+	sync.Mutex
+	vars map[string]bool
+}{vars: make(map[string]bool)}
+
+// LoadFromFile sets the variables of a .env file in the environment, where
+// Load picks them up. Variables that are already set take precedence,
+// unless an earlier call set them from the file: those take the new value
+// of the file, and are unset if the file no longer has them.
+func LoadFromFile(filename string) error {
+	values := make(map[string]string)
+	if _, err := os.Stat(filename); !errors.Is(err, os.ErrNotExist) {
+		env := viper.New()
+		env.SetConfigFile(filename)
+		env.SetConfigType("env")
+		if err := env.ReadInConfig(); err != nil {
+			return fmt.Errorf("failed to read env file: %w", err)
+		}
+		for _, key := range env.AllKeys() {
+			values[strings.ToUpper(key)] = env.GetString(key)
+		}
+	}
+
+	fromFile.Lock()
+	defer fromFile.Unlock()
+	for name := range fromFile.vars {
+		if _, ok := values[name]; !ok {
+			os.Unsetenv(name)
+			delete(fromFile.vars, name)
+		}
+	}
+	for name, value := range values {
+		if fromFile.vars[name] || os.Getenv(name) == "" {
+			os.Setenv(name, value)
+			fromFile.vars[name] = true
+		}
+	}
+	return nil
+}
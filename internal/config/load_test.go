@@ -0,0 +1,130 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+func TestLoadPrecedence(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "config.yaml")
+	err := os.WriteFile(file, []byte(`
+server:
+  port: "9090"
+  shutdown_timeout: 10s
+database:
+  password: from-file
+  pool:
+    max_open_conns: 25
+log:
+  level: debug
+  format: text
+`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(ConfigFileEnv, file)
+	t.Setenv("LOG_LEVEL", "warn")
+	t.Setenv("DB_PASSWORD", "from-env")
+	t.Setenv("DB_REPLICA_DSNS", "replica-1,replica-2")
+
+	fs := pflag.NewFlagSet("server", pflag.ContinueOnError)
+	AddFlags(fs)
+	if err := fs.Parse([]string{"--log-level=error", "--reflection=false"}); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := Load(fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.Server.Port != "9090" || cfg.Server.ShutdownTimeout != 10*time.Second || cfg.Database.Pool.MaxOpenConns != 25 {
+		t.Errorf("file settings not applied: %+v", cfg.Server)
+	}
+	if cfg.Database.Password != "from-env" {
+		t.Errorf("password = %q, want the environment to override the file", cfg.Database.Password)
+	}
+	if cfg.Log.Level != "error" || cfg.Log.Format != "text" {
+		t.Errorf("log = %+v, want the flag to override env and file", cfg.Log)
+	}
+	if cfg.Features.Reflection || !cfg.Features.Metrics {
+		t.Errorf("features = %+v", cfg.Features)
+	}
+	if r := cfg.Database.Replicas; len(r.DSNs) != 2 || r.DSNs[1] != "replica-2" || r.StickyWindow != 5*time.Second {
+		t.Errorf("replicas = %+v", r)
+	}
+	if cfg.Storage.MaxAttachmentSize != 10485760 || cfg.Database.Pool.ConnMaxLifetime != 3*time.Minute {
+		t.Errorf("defaults not applied: %+v %+v", cfg.Storage, cfg.Database.Pool)
+	}
+}
+
+func TestLoadRejectsUnknownKeys(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(file, []byte("[server]\nprot = \"9090\"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(ConfigFileEnv, file)
+	if _, err := Load(nil); err == nil || !strings.Contains(err.Error(), "prot") {
+		t.Errorf("Load error = %v, want one naming the unknown key", err)
+	}
+}
+
+func TestWriteYAMLRedactsSecrets(t *testing.T) {
+	cfg := &Config{}
+	cfg.Database.Password = "hunter2"
+	cfg.Database.User = "todo"
+	cfg.Storage.S3.SecretKey = "s3-secret"
+	cfg.Database.Replicas.DSNs = []string{"reader:hunter3@tcp(replica)/todo"}
+	cfg.Server.ShutdownTimeout = 30 * time.Second
+
+	var buf bytes.Buffer
+	if err := cfg.WriteYAML(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if strings.Contains(out, "hunter2") || strings.Contains(out, "s3-secret") || strings.Contains(out, "hunter3") {
+		t.Errorf("secrets leaked:\n%s", out)
+	}
+	for _, want := range []string{"password: " + Redacted, "user: todo", "shutdown_timeout: 30s", "dsn: \"\""} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output lacks %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestLoadFromFileReplacesItsValues(t *testing.T) {
+	file := filepath.Join(t.TempDir(), ".env")
+	write := func(content string) {
+		t.Helper()
+		if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := LoadFromFile(file); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// t.Setenv restores the variables; unset them for LoadFromFile to set
+	for _, name := range []string{"LOG_LEVEL", "RATE_LIMIT_RPS"} {
+		t.Setenv(name, "")
+		os.Unsetenv(name)
+	}
+	t.Setenv("DB_PASSWORD", "from-env")
+
+	write("LOG_LEVEL=debug\nRATE_LIMIT_RPS=5\nDB_PASSWORD=from-file\n")
+	write("LOG_LEVEL=warn\nDB_PASSWORD=from-file\n")
+
+	if got := os.Getenv("LOG_LEVEL"); got != "warn" {
+		t.Errorf("LOG_LEVEL = %q, want the edited value warn", got)
+	}
+	if got, ok := os.LookupEnv("RATE_LIMIT_RPS"); ok {
+		t.Errorf("RATE_LIMIT_RPS = %q, want it unset once removed from the file", got)
+	}
+	if got := os.Getenv("DB_PASSWORD"); got != "from-env" {
+		t.Errorf("DB_PASSWORD = %q, want the environment to override the file", got)
+	}
+}
@@ -0,0 +1,65 @@
+package config
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Redacted replaces the value of fields tagged secret:"true".
+const Redacted = "REDACTED"
+
+// WriteYAML writes the configuration in the layout of a config file, with
+// secrets redacted, so that the effective settings can be inspected.
+func (c *Config) WriteYAML(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(structNode(reflect.ValueOf(*c))); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// structNode builds the mapping by hand to keep the field order and the
+// json names used by Load, which yaml.v3 would not.
+func structNode(v reflect.Value) *yaml.Node {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		key := &yaml.Node{Kind: yaml.ScalarNode, Value: name}
+		node.Content = append(node.Content, key, valueNode(v.Field(i), field.Tag.Get("secret") == "true"))
+	}
+	return node
+}
+
+// isSet reports whether a secret has a value to hide. An empty list, as
+// the defaults give, has none.
+func isSet(v reflect.Value) bool {
+	if v.Kind() == reflect.Slice {
+		return v.Len() > 0
+	}
+	return !v.IsZero()
+}
+
+func valueNode(v reflect.Value, secret bool) *yaml.Node {
+	switch {
+	case secret && isSet(v):
+		return &yaml.Node{Kind: yaml.ScalarNode, Value: Redacted}
+	case v.Type() == reflect.TypeOf(time.Duration(0)):
+		return &yaml.Node{Kind: yaml.ScalarNode, Value: time.Duration(v.Int()).String()}
+	case v.Kind() == reflect.Struct:
+		return structNode(v)
+	}
+	node := &yaml.Node{}
+	// scalars of the config types always encode
+	_ = node.Encode(v.Interface())
+	return node
+}
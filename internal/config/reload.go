@@ -0,0 +1,73 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Change is a setting whose value differs between two configurations.
+// Secrets are redacted in Old and New.
+type Change struct {
+	Key      string `json:"key"` // e.g. "log.level"
+	Old      string `json:"old"`
+	New      string `json:"new"`
+	Reloaded bool   `json:"reloaded"`
+}
+
+// Diff lists the settings that differ between old and next. Settings
+// tagged reload:"true", or inside a struct so tagged, can be applied to a
+// running server; the others take effect on the next restart.
+func Diff(old, next *Config) []Change {
+	var changes []Change
+	walk(reflect.ValueOf(*old), reflect.ValueOf(*next), "", false, func(key string, o, n reflect.Value, field reflect.StructField, reload bool) {
+		secret := field.Tag.Get("secret") == "true"
+		if oldValue, newValue := format(o, secret), format(n, secret); !reflect.DeepEqual(o.Interface(), n.Interface()) {
+			changes = append(changes, Change{Key: key, Old: oldValue, New: newValue, Reloaded: reload})
+		}
+	})
+	return changes
+}
+
+// WithReloadable returns a copy of c with the reloadable settings of next.
+// It is the configuration a running server has after reloading next.
+func (c *Config) WithReloadable(next *Config) *Config {
+	merged := *c
+	walk(reflect.ValueOf(&merged).Elem(), reflect.ValueOf(*next), "", false, func(_ string, dst, src reflect.Value, _ reflect.StructField, reload bool) {
+		if reload {
+			dst.Set(src)
+		}
+	})
+	return &merged
+}
+
+// walk calls fn with each pair of leaf fields of a and b, which have the
+// same struct type.
+func walk(a, b reflect.Value, prefix string, reload bool, fn func(key string, a, b reflect.Value, field reflect.StructField, reload bool)) {
+	t := a.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		key := prefix + name
+		fieldReload := reload || field.Tag.Get("reload") == "true"
+		if field.Type.Kind() == reflect.Struct && field.Type != reflect.TypeOf(time.Duration(0)) {
+			walk(a.Field(i), b.Field(i), key+".", fieldReload, fn)
+			continue
+		}
+		fn(key, a.Field(i), b.Field(i), field, fieldReload)
+	}
+}
+
+func format(v reflect.Value, secret bool) string {
+	switch {
+	case secret && isSet(v):
+		return Redacted
+	case v.Type() == reflect.TypeOf(time.Duration(0)):
+		return time.Duration(v.Int()).String()
+	}
+	return fmt.Sprint(v.Interface())
+}
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestDiffAndWithReloadable(t *testing.T) {
+	t.Setenv("CORS_ALLOWED_ORIGINS", "https://app.example.com,https://admin.example.com")
+	old, err := Load(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"https://app.example.com", "https://admin.example.com"}; !slices.Equal(old.Server.CORS.AllowedOrigins, want) {
+		t.Fatalf("allowed origins = %q, want %q", old.Server.CORS.AllowedOrigins, want)
+	}
+
+	next := *old
+	next.Log.Level = "debug"
+	next.Features.Metrics = false
+	next.Server.ShutdownTimeout = time.Minute
+	next.Database.Password = "changed"
+
+	got := Diff(old, &next)
+	want := []Change{
+		{Key: "server.shutdown_timeout", Old: "30s", New: "1m0s"},
+		{Key: "database.password", Old: "", New: Redacted},
+		{Key: "log.level", Old: "info", New: "debug", Reloaded: true},
+		{Key: "features.metrics", Old: "true", New: "false", Reloaded: true},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Diff = %+v, want %+v", got, want)
+	}
+
+	merged := old.WithReloadable(&next)
+	if merged.Log.Level != "debug" || merged.Features.Metrics {
+		t.Errorf("reloadable settings not taken: %+v %+v", merged.Log, merged.Features)
+	}
+	if merged.Server.ShutdownTimeout != old.Server.ShutdownTimeout || merged.Database.Password != "" {
+		t.Error("settings that need a restart were taken")
+	}
+	if old.Log.Level != "info" {
+		t.Error("WithReloadable modified its receiver")
+	}
+}
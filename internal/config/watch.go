@@ -0,0 +1,66 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// watchDelay lets a burst of events, such as an editor writing a temporary
+// file and renaming it over the original, result in a single reload.
+const watchDelay = 200 * time.Millisecond
+
+// Watch calls onChange, from another goroutine, after the file at path has
+// been written, replaced or, as Kubernetes does for a mounted ConfigMap,
+// had its symlink target swapped. It stops when ctx is cancelled.
+func Watch(ctx context.Context, path string, onChange func()) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to watch config file: %w", err)
+	}
+	// watch the directory: a file replaced by rename is a new inode that a
+	// watch on the file itself would not follow
+	path = filepath.Clean(path)
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		watcher.Close()
+		return fmt.Errorf("failed to watch config file: %w", err)
+	}
+
+	target, _ := filepath.EvalSymlinks(path)
+	go func() {
+		defer watcher.Close()
+		var timer *time.Timer
+		schedule := func() {
+			if timer == nil {
+				timer = time.AfterFunc(watchDelay, onChange)
+			} else {
+				timer.Reset(watchDelay)
+			}
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				if timer != nil {
+					timer.Stop()
+				}
+				return
+			case event := <-watcher.Events:
+				if filepath.Clean(event.Name) == path && !event.Has(fsnotify.Chmod) {
+					schedule()
+					continue
+				}
+				if current, _ := filepath.EvalSymlinks(path); current != target {
+					target = current
+					schedule()
+				}
+			case <-watcher.Errors:
+				// events may have been lost; reading the file again is harmless
+				schedule()
+			}
+		}
+	}()
+	return nil
+}
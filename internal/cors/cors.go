@@ -0,0 +1,54 @@
+package cors
+
+import (
+	"net/http"
+	"slices"
+	"sync/atomic"
+
+	connectcors "connectrpc.com/cors"
+	"github.com/rs/cors"
+
+	"github.com/kogamitora/todo/internal/logging"
+	"github.com/kogamitora/todo/internal/ratelimit"
+)
+
+// Handler answers CORS preflight requests and adds the CORS headers for
+// the Connect, gRPC-Web and gRPC protocols. The allowed origins can be
+// changed while the server runs.
+type Handler struct {
+	origins atomic.Pointer[[]string]
+	cors    *cors.Cors
+}
+
+// New returns a handler allowing origins, where "*" allows any origin.
+// No origin is allowed when origins is empty.
+func New(origins []string) *Handler {
+	h := &Handler{}
+	h.SetOrigins(origins)
+	h.cors = cors.New(cors.Options{
+		AllowOriginFunc: h.allowed,
+		AllowedMethods:  connectcors.AllowedMethods(),
+		AllowedHeaders: append(connectcors.AllowedHeaders(),
+			logging.RequestIDHeader, logging.UserHeader, ratelimit.APIKeyHeader),
+		ExposedHeaders: append(connectcors.ExposedHeaders(),
+			logging.RequestIDHeader, "Retry-After"),
+		MaxAge: 7200, // 2 hours, the cap of Chromium
+	})
+	return h
+}
+
+// SetOrigins replaces the allowed origins.
+func (h *Handler) SetOrigins(origins []string) {
+	origins = slices.Clone(origins)
+	h.origins.Store(&origins)
+}
+
+// Wrap adds CORS to next.
+func (h *Handler) Wrap(next http.Handler) http.Handler {
+	return h.cors.Handler(next)
+}
+
+func (h *Handler) allowed(origin string) bool {
+	origins := *h.origins.Load()
+	return slices.Contains(origins, "*") || slices.Contains(origins, origin)
+}
@@ -0,0 +1,39 @@
+package cors
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func preflight(h http.Handler, origin string) string {
+	req := httptest.NewRequest(http.MethodOptions, "/todo.v1.TodoService/GetTodos", nil)
+	req.Header.Set("Origin", origin)
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	// browsers send the names lowercased and sorted
+	req.Header.Set("Access-Control-Request-Headers", "connect-protocol-version,content-type,x-user")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec.Header().Get("Access-Control-Allow-Origin")
+}
+
+func TestHandlerOrigins(t *testing.T) {
+	c := New(nil)
+	h := c.Wrap(http.NotFoundHandler())
+	if got := preflight(h, "https://app.example.com"); got != "" {
+		t.Errorf("origin allowed with no origins configured: %q", got)
+	}
+
+	c.SetOrigins([]string{"https://app.example.com"})
+	if got := preflight(h, "https://app.example.com"); got != "https://app.example.com" {
+		t.Errorf("Access-Control-Allow-Origin = %q, want the configured origin", got)
+	}
+	if got := preflight(h, "https://evil.example.com"); got != "" {
+		t.Errorf("other origin allowed: %q", got)
+	}
+
+	c.SetOrigins([]string{"*"})
+	if got := preflight(h, "https://evil.example.com"); got == "" {
+		t.Error("origin rejected with \"*\" configured")
+	}
+}
@@ -1,30 +1,217 @@
 package db
 
 import (
+	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"log/slog"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
-	_ "github.com/go-sql-driver/mysql"
+	"github.com/XSAM/otelsql"
+	"github.com/go-sql-driver/mysql"
+	_ "github.com/jackc/pgx/v5/stdlib" // registers the "pgx" driver
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	_ "modernc.org/sqlite" // registers the "sqlite" driver
+
+	"github.com/kogamitora/todo/internal/config"
+)
+
+// Backoff between connection attempts at startup.
+const (
+	initialRetryDelay = 250 * time.Millisecond
+	maxRetryDelay     = 5 * time.Second
 )
 
-func NewDB(dsn string, logger *slog.Logger) (*sql.DB, error) {
-	db, err := sql.Open("mysql", dsn)
+// NewDB opens a pool with the settings of cfg and waits, for up to
+// cfg.Timeouts.Startup, until the database accepts connections.
+func NewDB(dsn string, cfg config.DatabaseConfig, logger *slog.Logger) (*sql.DB, error) {
+	db, err := Open(dsn, cfg)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open database: %w", err)
+		return nil, err
 	}
 
-	// Set connection pool parameters
-	db.SetConnMaxLifetime(time.Minute * 3)
-	db.SetMaxOpenConns(10)
-	db.SetMaxIdleConns(10)
-
 	// Ping the database to ensure connection is established
-	if err := db.Ping(); err != nil {
+	if err := ping(db, cfg.Timeouts.Startup, logger); err != nil {
+		db.Close()
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
 	logger.Info("Database connection established")
 	return db, nil
 }
+
+// Open opens a pool with the settings of cfg without connecting, as for a
+// replica that may come up after the server.
+func Open(dsn string, cfg config.DatabaseConfig) (*sql.DB, error) {
+	var system attribute.KeyValue
+	var err error
+	switch cfg.Driver {
+	case config.DriverSQLite:
+		system = semconv.DBSystemNameSQLite
+		dsn = sqliteDSN(dsn, cfg.Timeouts.Query)
+	case config.DriverPostgres:
+		system = semconv.DBSystemNamePostgreSQL
+		if dsn, err = postgresDSN(dsn, cfg.Timeouts.Connect); err != nil {
+			return nil, err
+		}
+	default:
+		system = semconv.DBSystemNameMySQL
+		if dsn, err = withTimeouts(dsn, cfg.Timeouts); err != nil {
+			return nil, err
+		}
+	}
+
+	// every query run within a traced RPC gets a child span
+	db, err := otelsql.Open(driverName(cfg.Driver), dsn,
+		otelsql.WithAttributes(system),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			DisableErrSkip:       true,
+			OmitConnResetSession: true,
+			OmitConnPrepare:      true,
+			OmitRows:             true,
+			SpanFilter:           hasParentSpan,
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	// Set connection pool parameters
+	db.SetMaxOpenConns(cfg.Pool.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.Pool.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.Pool.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.Pool.ConnMaxIdleTime)
+	return db, nil
+}
+
+// ping retries with exponential backoff until the database answers or
+// timeout has passed. A zero timeout tries once.
+func ping(db *sql.DB, timeout time.Duration, logger *slog.Logger) error {
+	deadline := time.Now().Add(timeout)
+	delay := initialRetryDelay
+	for attempt := 1; ; attempt++ {
+		err := db.Ping()
+		if err == nil {
+			return nil
+		}
+		if time.Now().Add(delay).After(deadline) {
+			return err
+		}
+		logger.Warn("database not ready, retrying", "attempt", attempt, "retry_in", delay, "error", err)
+		time.Sleep(delay)
+		delay = min(2*delay, maxRetryDelay)
+	}
+}
+
+// withTimeouts adds the driver timeouts to dsn, keeping those it already
+// sets.
+func withTimeouts(dsn string, timeouts config.TimeoutsConfig) (string, error) {
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return "", fmt.Errorf("invalid database DSN: %w", err)
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = timeouts.Connect
+	}
+	if cfg.ReadTimeout == 0 {
+		cfg.ReadTimeout = timeouts.Read
+	}
+	if cfg.WriteTimeout == 0 {
+		cfg.WriteTimeout = timeouts.Write
+	}
+	return cfg.FormatDSN(), nil
+}
+
+// postgresDSN adds the connect timeout to dsn, a URL or a list of
+// key=value settings, unless it already sets one. PostgreSQL has no
+// read and write timeouts; the queries of an RPC are bounded by their
+// context instead.
+func postgresDSN(dsn string, connect time.Duration) (string, error) {
+	// the driver counts the timeout in whole seconds, and zero means none
+	seconds := int(connect.Round(time.Second).Seconds())
+	if seconds == 0 {
+		return dsn, nil
+	}
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		u, err := url.Parse(dsn)
+		if err != nil {
+			return "", fmt.Errorf("invalid database DSN: %w", err)
+		}
+		q := u.Query()
+		if !q.Has("connect_timeout") {
+			q.Set("connect_timeout", strconv.Itoa(seconds))
+			u.RawQuery = q.Encode()
+		}
+		return u.String(), nil
+	}
+	if strings.Contains(dsn, "connect_timeout=") {
+		return dsn, nil
+	}
+	return strings.TrimSpace(dsn + " connect_timeout=" + strconv.Itoa(seconds)), nil
+}
+
+// driverName returns the database/sql driver of a config.Database.Driver.
+func driverName(driver string) string {
+	switch driver {
+	case config.DriverSQLite:
+		return "sqlite"
+	case config.DriverPostgres:
+		return "pgx"
+	}
+	return "mysql"
+}
+
+// defaultBusyTimeout is how long a SQLite connection waits for the lock
+// held by another one when DB_QUERY_TIMEOUT is not set.
+const defaultBusyTimeout = 5 * time.Second
+
+// sqliteDSN turns a database file into a DSN that enforces foreign keys,
+// allows readers during a write (WAL), waits up to busyTimeout for a lock
+// and stores times in a format that sorts as text. Settings already in the
+// DSN win.
+func sqliteDSN(path string, busyTimeout time.Duration) string {
+	if busyTimeout <= 0 {
+		busyTimeout = defaultBusyTimeout
+	}
+	file, query, _ := strings.Cut(path, "?")
+	params, err := url.ParseQuery(query)
+	if err != nil {
+		// let the driver report the invalid DSN
+		return path
+	}
+
+	for _, p := range []struct{ name, value string }{
+		{"foreign_keys", "foreign_keys(1)"},
+		{"journal_mode", "journal_mode(WAL)"},
+		{"busy_timeout", fmt.Sprintf("busy_timeout(%d)", busyTimeout.Milliseconds())},
+	} {
+		if !hasPragma(params["_pragma"], p.name) {
+			params.Add("_pragma", p.value)
+		}
+	}
+	if !params.Has("_time_format") {
+		params.Set("_time_format", "sqlite")
+	}
+	return file + "?" + params.Encode()
+}
+
+func hasPragma(pragmas []string, name string) bool {
+	for _, p := range pragmas {
+		if strings.HasPrefix(strings.ToLower(p), name) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasParentSpan skips spans for queries outside of a trace, such as the
+// readiness probe's pings.
+func hasParentSpan(ctx context.Context, _ otelsql.Method, _ string, _ []driver.NamedValue) bool {
+	return trace.SpanContextFromContext(ctx).IsValid()
+}
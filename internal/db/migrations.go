@@ -0,0 +1,282 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database"
+	migratemysql "github.com/golang-migrate/migrate/v4/database/mysql"
+	migratepgx "github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	migratesqlite "github.com/golang-migrate/migrate/v4/database/sqlite"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+
+	"github.com/kogamitora/todo/internal/config"
+	"github.com/kogamitora/todo/migrations"
+	postgresmigrations "github.com/kogamitora/todo/migrations/postgres"
+	sqlitemigrations "github.com/kogamitora/todo/migrations/sqlite"
+)
+
+// migrationFile matches golang-migrate file names such as 000001_create_todos_table.up.sql.
+var migrationFile = regexp.MustCompile(`^(\d+)_(.+)\.up\.sql$`)
+
+// Migrations returns the migrations in dir, or those embedded in the
+// binary for driver if dir is empty.
+func Migrations(driver, dir string) fs.FS {
+	if dir != "" {
+		return os.DirFS(dir)
+	}
+	switch driver {
+	case config.DriverSQLite:
+		return sqlitemigrations.FS
+	case config.DriverPostgres:
+		return postgresmigrations.FS
+	}
+	return migrations.FS
+}
+
+// Migration is one schema version.
+type Migration struct {
+	Version uint
+	Name    string // e.g. "create_todos_table"
+}
+
+// ListMigrations returns the migrations of fsys in version order.
+func ListMigrations(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations directory: %w", err)
+	}
+
+	var list []Migration
+	for _, e := range entries {
+		m := migrationFile.FindStringSubmatch(e.Name())
+		if m == nil {
+			continue
+		}
+		v, err := strconv.ParseUint(m[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration file name %q: %w", e.Name(), err)
+		}
+		list = append(list, Migration{Version: uint(v), Name: m[2]})
+	}
+	if len(list) == 0 {
+		return nil, errors.New("no migrations found")
+	}
+	// ReadDir sorts by file name, which the zero padding keeps in version order
+	return list, nil
+}
+
+// LatestMigrationVersion returns the highest migration version in fsys.
+func LatestMigrationVersion(fsys fs.FS) (uint, error) {
+	list, err := ListMigrations(fsys)
+	if err != nil {
+		return 0, err
+	}
+	return list[len(list)-1].Version, nil
+}
+
+// SchemaVersion returns the version recorded by golang-migrate in the
+// schema_migrations table. dirty is true if a migration failed halfway.
+func SchemaVersion(ctx context.Context, db *sql.DB) (version uint, dirty bool, err error) {
+	err = db.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return version, dirty, nil
+}
+
+// migrateLock prefixes the name of the advisory lock held while migrating
+// a database, and
+// migrateLockWait how long a server waits for another one to finish.
+// golang-migrate takes a lock of its own, but gives up on it after 10
+// seconds, which would fail the startup of replicas started together.
+const (
+	migrateLock     = "todo:migrate:"
+	migrateLockWait = 10 * time.Minute
+)
+
+// Migrator applies the migrations of an fs.FS to a MySQL, PostgreSQL or
+// SQLite database.
+type Migrator struct {
+	fsys   fs.FS
+	driver string
+	db     *sql.DB
+	conn   *sql.Conn // holding the advisory lock, nil for SQLite
+	m      *migrate.Migrate
+}
+
+// NewMigrator connects to dsn for migrating. It opens its own connection,
+// without the timeouts of the server's pool, as a migration that rebuilds a
+// table can take long.
+func NewMigrator(ctx context.Context, driver, dsn string, fsys fs.FS) (*Migrator, error) {
+	switch driver {
+	case config.DriverSQLite:
+		dsn = sqliteDSN(dsn, 0)
+	case config.DriverPostgres:
+		// the driver runs a file without parameters as one multi-statement query
+	case config.DriverMemory:
+		return nil, fmt.Errorf("the %s driver has no schema to migrate", driver)
+	default:
+		cfg, err := mysql.ParseDSN(dsn)
+		if err != nil {
+			return nil, fmt.Errorf("invalid database DSN: %w", err)
+		}
+		// a migration file may hold several statements
+		cfg.MultiStatements = true
+		dsn = cfg.FormatDSN()
+	}
+
+	db, err := sql.Open(driverName(driver), dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	mg := &Migrator{fsys: fsys, driver: driver, db: db}
+	if err := mg.open(ctx); err != nil {
+		mg.Close()
+		return nil, err
+	}
+	return mg, nil
+}
+
+func (mg *Migrator) open(ctx context.Context) error {
+	var target database.Driver
+	var err error
+	if mg.driver != config.DriverSQLite {
+		if mg.conn, err = mg.db.Conn(ctx); err != nil {
+			return fmt.Errorf("failed to connect to database: %w", err)
+		}
+	}
+	switch mg.driver {
+	case config.DriverSQLite:
+		target, err = migratesqlite.WithInstance(mg.db, &migratesqlite.Config{})
+	case config.DriverPostgres:
+		// the driver takes a connection of its own from the pool
+		target, err = migratepgx.WithInstance(mg.db, &migratepgx.Config{})
+	default:
+		target, err = migratemysql.WithConnection(ctx, mg.conn, &migratemysql.Config{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to prepare migrations: %w", err)
+	}
+	source, err := iofs.New(mg.fsys, ".")
+	if err != nil {
+		return fmt.Errorf("failed to read migrations: %w", err)
+	}
+	mg.m, err = migrate.NewWithInstance("iofs", source, driverName(mg.driver), target)
+	if err != nil {
+		return fmt.Errorf("failed to prepare migrations: %w", err)
+	}
+	return nil
+}
+
+// Up applies all pending migrations and returns the schema versions before
+// and after. Servers migrating at the same time take turns; the later ones
+// find nothing left to do.
+func (mg *Migrator) Up(ctx context.Context) (from, to uint, err error) {
+	err = mg.locked(ctx, func() error {
+		if from, _, err = mg.Version(); err != nil {
+			return err
+		}
+		if err = ignoreNoChange(mg.m.Up()); err != nil {
+			return err
+		}
+		to, _, err = mg.Version()
+		return err
+	})
+	return from, to, err
+}
+
+// Down reverts the last steps migrations.
+func (mg *Migrator) Down(ctx context.Context, steps int) error {
+	return mg.locked(ctx, func() error {
+		return ignoreNoChange(mg.m.Steps(-steps))
+	})
+}
+
+// Migrations lists the migrations the migrator applies.
+func (mg *Migrator) Migrations() ([]Migration, error) {
+	return ListMigrations(mg.fsys)
+}
+
+// Version returns the current schema version, zero if none is applied.
+func (mg *Migrator) Version() (version uint, dirty bool, err error) {
+	version, dirty, err = mg.m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	}
+	return version, dirty, err
+}
+
+// Close releases the connections.
+func (mg *Migrator) Close() error {
+	if mg.m != nil {
+		// closing the driver closes mg.conn for MySQL, or mg.db for
+		// PostgreSQL and SQLite
+		_, err := mg.m.Close()
+		if mg.conn != nil {
+			mg.conn.Close()
+		}
+		mg.db.Close()
+		return err
+	}
+	if mg.conn != nil {
+		mg.conn.Close()
+	}
+	return mg.db.Close()
+}
+
+// locked runs fn holding the migrate advisory lock. SQLite has no such
+// lock, as a database file is served by a single server.
+func (mg *Migrator) locked(ctx context.Context, fn func() error) error {
+	switch mg.driver {
+	case config.DriverSQLite:
+		return fn()
+	case config.DriverPostgres:
+		return mg.lockedPostgres(ctx, fn)
+	}
+	var acquired sql.NullBool
+	err := mg.conn.QueryRowContext(ctx, "SELECT GET_LOCK(CONCAT(?, DATABASE()), ?)", migrateLock, int(migrateLockWait.Seconds())).Scan(&acquired)
+	if err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	if !acquired.Bool {
+		return fmt.Errorf("timed out after %s waiting for another server to finish migrating", migrateLockWait)
+	}
+	defer mg.conn.ExecContext(context.WithoutCancel(ctx), "SELECT RELEASE_LOCK(CONCAT(?, DATABASE()))", migrateLock)
+	return fn()
+}
+
+// lockedPostgres is locked for PostgreSQL, whose advisory locks are keyed
+// by a number rather than a name, and wait without a timeout of their own.
+func (mg *Migrator) lockedPostgres(ctx context.Context, fn func() error) error {
+	const key = "hashtext($1::text || current_database())"
+	waitCtx, cancel := context.WithTimeout(ctx, migrateLockWait)
+	defer cancel()
+	if _, err := mg.conn.ExecContext(waitCtx, "SELECT pg_advisory_lock("+key+")", migrateLock); err != nil {
+		if errors.Is(waitCtx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("timed out after %s waiting for another server to finish migrating", migrateLockWait)
+		}
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer mg.conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock("+key+")", migrateLock)
+	return fn()
+}
+
+func ignoreNoChange(err error) error {
+	if errors.Is(err, migrate.ErrNoChange) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,41 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/kogamitora/todo/internal/config"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	for driver, dir := range map[string]string{
+		config.DriverMySQL:    "../../migrations",
+		config.DriverPostgres: "../../migrations/postgres",
+		config.DriverSQLite:   "../../migrations/sqlite",
+	} {
+		t.Run(driver, func(t *testing.T) {
+			testEmbeddedMigrations(t, driver, dir)
+		})
+	}
+}
+
+func testEmbeddedMigrations(t *testing.T, driver, dir string) {
+	embedded, err := ListMigrations(Migrations(driver, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	onDisk, err := ListMigrations(Migrations(driver, dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(embedded) != len(onDisk) {
+		t.Fatalf("%d migrations embedded, %d in %s", len(embedded), len(onDisk), dir)
+	}
+	for i, m := range embedded {
+		if m.Version != uint(i+1) {
+			t.Errorf("migration %d has version %d, want versions without gaps", i, m.Version)
+		}
+		if m != onDisk[i] {
+			t.Errorf("embedded %+v, on disk %+v", m, onDisk[i])
+		}
+	}
+}
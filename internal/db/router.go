@@ -0,0 +1,284 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// Router sends the queries of read-only calls to read replicas and
+// everything else to the primary. It implements the executor interfaces of
+// the repositories, so it can stand in for the primary *sql.DB.
+//
+// A replica takes reads only while it answers the health checks of Watch.
+// When none does, or a query fails on its replica but not on the primary,
+// reads go to the primary.
+type Router struct {
+	primary  *sql.DB
+	replicas []*replica
+	next     atomic.Uint64
+	logger   *slog.Logger
+
+	// stickyWindow is how long a client reads from the primary after its
+	// last write.
+	stickyWindow time.Duration
+
+	mu        sync.Mutex
+	lastWrite map[string]time.Time
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type replica struct {
+	db      *sql.DB
+	index   int
+	healthy atomic.Bool
+	checked atomic.Bool
+}
+
+// NewRouter returns a Router over primary and replicas. The replicas take
+// no reads until the first health check of Watch has passed.
+func NewRouter(primary *sql.DB, replicas []*sql.DB, stickyWindow time.Duration, logger *slog.Logger) *Router {
+	r := &Router{
+		primary:      primary,
+		logger:       logger,
+		stickyWindow: stickyWindow,
+		lastWrite:    make(map[string]time.Time),
+		now:          time.Now,
+	}
+	for i, db := range replicas {
+		r.replicas = append(r.replicas, &replica{db: db, index: i})
+	}
+	return r
+}
+
+type readOnlyKey struct{}
+
+// ReadOnly marks ctx so that its queries may be served by a replica, which
+// can lag behind the primary.
+func ReadOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlyKey{}, true)
+}
+
+func isReadOnly(ctx context.Context) bool {
+	readOnly, _ := ctx.Value(readOnlyKey{}).(bool)
+	return readOnly
+}
+
+// Watch pings the replicas now and then every interval until ctx is done.
+func (r *Router) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		r.check(ctx, interval)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// check pings each replica, allowing it timeout to answer.
+func (r *Router) check(ctx context.Context, timeout time.Duration) {
+	for _, rep := range r.replicas {
+		pingCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := rep.db.PingContext(pingCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		r.setHealthy(rep, err)
+	}
+}
+
+// setHealthy records the outcome of a ping, or the error of a query that
+// failed on the replica alone, and logs the first one and changes.
+func (r *Router) setHealthy(rep *replica, err error) {
+	healthy := err == nil
+	first := !rep.checked.Swap(true)
+	if rep.healthy.Swap(healthy) == healthy && !first {
+		return
+	}
+	if healthy {
+		r.logger.Info("database replica is up", "replica", rep.index)
+	} else {
+		r.logger.Warn("database replica is down, reading from the primary", "replica", rep.index, "error", err)
+	}
+}
+
+// replica returns a healthy replica in turn, or nil if ctx is not read-only
+// or there is none.
+func (r *Router) replica(ctx context.Context) *replica {
+	if len(r.replicas) == 0 || !isReadOnly(ctx) {
+		return nil
+	}
+	start := r.next.Add(1)
+	for i := range uint64(len(r.replicas)) {
+		rep := r.replicas[(start+i)%uint64(len(r.replicas))]
+		if rep.healthy.Load() {
+			return rep
+		}
+	}
+	return nil
+}
+
+// QueryContext runs a read-only query on a replica, retrying it on the
+// primary if it fails there. If the primary answers, the replica is taken
+// out of rotation until a health check of Watch passes again; if it fails
+// too, the query itself is at fault and the replica stays. The request
+// never waits for a ping.
+func (r *Router) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	rep := r.replica(ctx)
+	if rep == nil {
+		return r.primary.QueryContext(ctx, query, args...)
+	}
+	rows, err := rep.db.QueryContext(ctx, query, args...)
+	if err == nil || ctx.Err() != nil {
+		return rows, err
+	}
+	rows, primaryErr := r.primary.QueryContext(ctx, query, args...)
+	if primaryErr == nil {
+		r.setHealthy(rep, err)
+	}
+	return rows, primaryErr
+}
+
+// QueryRowContext is QueryContext for a single row.
+func (r *Router) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	rep := r.replica(ctx)
+	if rep == nil {
+		return r.primary.QueryRowContext(ctx, query, args...)
+	}
+	row := rep.db.QueryRowContext(ctx, query, args...)
+	err := row.Err()
+	if err == nil || ctx.Err() != nil {
+		return row
+	}
+	row = r.primary.QueryRowContext(ctx, query, args...)
+	if row.Err() == nil {
+		r.setHealthy(rep, err)
+	}
+	return row
+}
+
+func (r *Router) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return r.primary.ExecContext(ctx, query, args...)
+}
+
+// BeginTx starts a transaction on the primary, as its queries may be
+// followed by writes.
+func (r *Router) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	return r.primary.BeginTx(ctx, opts)
+}
+
+func (r *Router) Exec(query string, args ...any) (sql.Result, error) {
+	return r.primary.Exec(query, args...)
+}
+
+func (r *Router) Query(query string, args ...any) (*sql.Rows, error) {
+	return r.primary.Query(query, args...)
+}
+
+func (r *Router) QueryRow(query string, args ...any) *sql.Row {
+	return r.primary.QueryRow(query, args...)
+}
+
+// Close closes the replicas; the primary is closed by its owner.
+func (r *Router) Close() error {
+	var first error
+	for _, rep := range r.replicas {
+		if err := rep.db.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// wrote records that the client key may have changed data.
+func (r *Router) wrote(key string) {
+	if r.stickyWindow <= 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	if now.Sub(r.lastSweep) >= r.stickyWindow {
+		for k, t := range r.lastWrite {
+			if now.Sub(t) >= r.stickyWindow {
+				delete(r.lastWrite, k)
+			}
+		}
+		r.lastSweep = now
+	}
+	r.lastWrite[key] = now
+}
+
+// sticky reports whether the client key wrote within the sticky window.
+func (r *Router) sticky(key string) bool {
+	if r.stickyWindow <= 0 {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.lastWrite[key]
+	return ok && r.now().Sub(t) < r.stickyWindow
+}
+
+// Interceptor marks the calls of the procedures in reads as read-only,
+// unless the client wrote within the sticky window, so that it reads its
+// own writes. Calls of the procedures in writes record that the client
+// wrote; calls of any other procedure go to the primary and change
+// nothing. key tells the clients apart, as ratelimit.Key does.
+//
+// The writes are remembered by this process only: a client whose next
+// call reaches another server may still read from a lagging replica.
+func (r *Router) Interceptor(key func(http.Header, connect.Peer) string, reads, writes []string) connect.Interceptor {
+	return &routerInterceptor{router: r, key: key, reads: reads, writes: writes}
+}
+
+type routerInterceptor struct {
+	router *Router
+	key    func(http.Header, connect.Peer) string
+	reads  []string
+	writes []string
+}
+
+func (i *routerInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		procedure := req.Spec().Procedure
+		switch {
+		case slices.Contains(i.writes, procedure):
+			defer i.router.wrote(i.key(req.Header(), req.Peer()))
+		case slices.Contains(i.reads, procedure):
+			if !i.router.sticky(i.key(req.Header(), req.Peer())) {
+				ctx = ReadOnly(ctx)
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *routerInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *routerInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		// streams, such as uploads, are never routed to a replica
+		if slices.Contains(i.writes, conn.Spec().Procedure) {
+			defer i.router.wrote(i.key(conn.RequestHeader(), conn.Peer()))
+		}
+		return next(ctx, conn)
+	}
+}
@@ -0,0 +1,218 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// openNamed opens a SQLite database whose only row says name, to tell
+// which database served a query.
+func openNamed(t *testing.T, name string) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), name+".db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if _, err := db.Exec(`CREATE TABLE source (name TEXT)`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`INSERT INTO source VALUES (?)`, name); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func newTestRouter(t *testing.T, stickyWindow time.Duration, replicas ...string) *Router {
+	t.Helper()
+	var dbs []*sql.DB
+	for _, name := range replicas {
+		dbs = append(dbs, openNamed(t, name))
+	}
+	r := NewRouter(openNamed(t, "primary"), dbs, stickyWindow, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	r.check(context.Background(), time.Second)
+	return r
+}
+
+// source returns the database that served ctx's queries.
+func source(t *testing.T, r *Router, ctx context.Context) string {
+	t.Helper()
+	var name string
+	if err := r.QueryRowContext(ctx, `SELECT name FROM source`).Scan(&name); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := r.QueryContext(ctx, `SELECT name FROM source`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var other string
+		if err := rows.Scan(&other); err != nil {
+			t.Fatal(err)
+		}
+		if other != name {
+			t.Errorf("QueryRowContext read from %s but QueryContext from %s", name, other)
+		}
+	}
+	return name
+}
+
+func TestRouterRoutesReadOnlyQueries(t *testing.T) {
+	r := newTestRouter(t, 0, "replica")
+	if got := source(t, r, context.Background()); got != "primary" {
+		t.Errorf("query read from %s, want primary", got)
+	}
+	if got := source(t, r, ReadOnly(context.Background())); got != "replica" {
+		t.Errorf("read-only query read from %s, want replica", got)
+	}
+	if _, err := r.ExecContext(ReadOnly(context.Background()), `INSERT INTO source VALUES ('written')`); err != nil {
+		t.Fatal(err)
+	}
+	var n int
+	if err := r.primary.QueryRow(`SELECT COUNT(*) FROM source`).Scan(&n); err != nil || n != 2 {
+		t.Errorf("primary has %d rows (%v), want the write", n, err)
+	}
+}
+
+func TestRouterRoundRobin(t *testing.T) {
+	r := newTestRouter(t, 0, "a", "b")
+	seen := map[string]int{}
+	for range 4 {
+		var name string
+		if err := r.QueryRowContext(ReadOnly(context.Background()), `SELECT name FROM source`).Scan(&name); err != nil {
+			t.Fatal(err)
+		}
+		seen[name]++
+	}
+	if seen["a"] != 2 || seen["b"] != 2 {
+		t.Errorf("reads per replica = %v, want 2 each", seen)
+	}
+}
+
+func TestRouterFallsBackToPrimary(t *testing.T) {
+	r := NewRouter(openNamed(t, "primary"), []*sql.DB{openNamed(t, "replica")}, 0, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx := ReadOnly(context.Background())
+	if got := source(t, r, ctx); got != "primary" {
+		t.Errorf("read from %s before the first health check, want primary", got)
+	}
+
+	r.check(context.Background(), time.Second)
+	if got := source(t, r, ctx); got != "replica" {
+		t.Fatalf("read from %s, want replica", got)
+	}
+
+	// the replica goes away between health checks
+	r.replicas[0].db.Close()
+	if got := source(t, r, ctx); got != "primary" {
+		t.Errorf("read from %s with the replica down, want primary", got)
+	}
+	if r.replicas[0].healthy.Load() {
+		t.Error("failed replica still healthy")
+	}
+	r.check(context.Background(), time.Second)
+	if r.replicas[0].healthy.Load() {
+		t.Error("closed replica passed the health check")
+	}
+}
+
+func TestRouterRetriesOnPrimary(t *testing.T) {
+	r := newTestRouter(t, 0, "replica")
+	ctx := ReadOnly(context.Background())
+	// the replica fails the query but still answers pings
+	if _, err := r.replicas[0].db.Exec(`DROP TABLE source`); err != nil {
+		t.Fatal(err)
+	}
+	if got := source(t, r, ctx); got != "primary" {
+		t.Errorf("read from %s, want the primary to retry the query", got)
+	}
+	if r.replicas[0].healthy.Load() {
+		t.Error("replica still in rotation after failing a query the primary answered")
+	}
+	r.check(context.Background(), time.Second)
+	if !r.replicas[0].healthy.Load() {
+		t.Error("replica not back in rotation after a health check")
+	}
+}
+
+func TestRouterKeepsQueryErrors(t *testing.T) {
+	r := newTestRouter(t, 0, "replica")
+	if _, err := r.QueryContext(ReadOnly(context.Background()), `SELECT nothing FROM nowhere`); err == nil {
+		t.Fatal("invalid query succeeded")
+	}
+	if !r.replicas[0].healthy.Load() {
+		t.Error("replica taken out of rotation by an invalid query")
+	}
+}
+
+func TestRouterInterceptor(t *testing.T) {
+	const (
+		readProcedure  = "/test.v1.Service/Read"
+		writeProcedure = "/test.v1.Service/Write"
+		otherProcedure = "/test.v1.Service/Other"
+	)
+	r := newTestRouter(t, 5*time.Second, "replica")
+	now := time.Now()
+	r.now = func() time.Time { return now }
+
+	read := func(ctx context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[wrapperspb.StringValue], error) {
+		var name string
+		err := r.QueryRowContext(ctx, `SELECT name FROM source`).Scan(&name)
+		return connect.NewResponse(wrapperspb.String(name)), err
+	}
+	interceptors := connect.WithInterceptors(r.Interceptor(func(h http.Header, _ connect.Peer) string {
+		return h.Get("X-Client")
+	}, []string{readProcedure}, []string{writeProcedure}))
+	mux := http.NewServeMux()
+	mux.Handle(readProcedure, connect.NewUnaryHandler(readProcedure, read, interceptors))
+	mux.Handle(writeProcedure, connect.NewUnaryHandler(writeProcedure, read, interceptors))
+	mux.Handle(otherProcedure, connect.NewUnaryHandler(otherProcedure, read, interceptors))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	call := func(procedure, client string) string {
+		t.Helper()
+		req := connect.NewRequest(&emptypb.Empty{})
+		req.Header().Set("X-Client", client)
+		res, err := connect.NewClient[emptypb.Empty, wrapperspb.StringValue](srv.Client(), srv.URL+procedure).CallUnary(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.Msg.Value
+	}
+
+	if got := call(readProcedure, "alice"); got != "replica" {
+		t.Errorf("read from %s, want replica", got)
+	}
+	// neither a read nor a write
+	if got := call(otherProcedure, "alice"); got != "primary" {
+		t.Errorf("other call read from %s, want primary", got)
+	}
+	if got := call(readProcedure, "alice"); got != "replica" {
+		t.Errorf("read after another call from %s, want replica", got)
+	}
+	if got := call(writeProcedure, "alice"); got != "primary" {
+		t.Errorf("write call read from %s, want primary", got)
+	}
+	if got := call(readProcedure, "alice"); got != "primary" {
+		t.Errorf("read after a write from %s, want primary", got)
+	}
+	if got := call(readProcedure, "bob"); got != "replica" {
+		t.Errorf("read of another client from %s, want replica", got)
+	}
+	now = now.Add(5 * time.Second)
+	if got := call(readProcedure, "alice"); got != "replica" {
+		t.Errorf("read after the sticky window from %s, want replica", got)
+	}
+}
@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// replyMargin is kept from the client's deadline so that a query timing
+// out still leaves time to send the error, rather than the client giving up
+// first.
+const replyMargin = 50 * time.Millisecond
+
+// NewTimeoutInterceptor gives the queries of each unary call the client's
+// deadline less a margin, capped at max. Zero max leaves calls without a
+// client deadline unbounded. Streams are left alone: they run for as long
+// as the client keeps sending.
+func NewTimeoutInterceptor(max time.Duration) connect.Interceptor {
+	return &timeoutInterceptor{max: max}
+}
+
+type timeoutInterceptor struct {
+	max time.Duration
+}
+
+func (i *timeoutInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		ctx, cancel := QueryContext(ctx, i.max, time.Now())
+		defer cancel()
+		return next(ctx, req)
+	}
+}
+
+func (i *timeoutInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *timeoutInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// QueryContext derives the context for the queries of a call from its
+// deadline, as described at NewTimeoutInterceptor.
+func QueryContext(ctx context.Context, max time.Duration, now time.Time) (context.Context, context.CancelFunc) {
+	var deadline time.Time
+	if max > 0 {
+		deadline = now.Add(max)
+	}
+	if clientDeadline, ok := ctx.Deadline(); ok {
+		// a deadline too close to keep a margin is used as is
+		if d := clientDeadline.Add(-replyMargin); d.After(now) {
+			clientDeadline = d
+		}
+		if deadline.IsZero() || clientDeadline.Before(deadline) {
+			deadline = clientDeadline
+		}
+	}
+	if deadline.IsZero() {
+		return context.WithCancel(ctx)
+	}
+	return context.WithDeadline(ctx, deadline)
+}
@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kogamitora/todo/internal/config"
+)
+
+func TestQueryContext(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name           string
+		clientDeadline time.Duration // zero for none
+		max            time.Duration
+		want           time.Duration // zero for no deadline
+	}{
+		{"no deadline", 0, 0, 0},
+		{"max only", 0, 10 * time.Second, 10 * time.Second},
+		{"client deadline shorter", 2 * time.Second, 10 * time.Second, 2*time.Second - replyMargin},
+		{"max shorter", time.Minute, 10 * time.Second, 10 * time.Second},
+		{"client deadline without max", 2 * time.Second, 0, 2*time.Second - replyMargin},
+		{"no room for the margin", 10 * time.Millisecond, 0, 10 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.clientDeadline > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithDeadline(ctx, now.Add(tt.clientDeadline))
+				defer cancel()
+			}
+			ctx, cancel := QueryContext(ctx, tt.max, now)
+			defer cancel()
+
+			deadline, ok := ctx.Deadline()
+			if tt.want == 0 {
+				if ok {
+					t.Errorf("deadline in %s, want none", deadline.Sub(now))
+				}
+				return
+			}
+			if got := deadline.Sub(now); !ok || got != tt.want {
+				t.Errorf("deadline in %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithTimeouts(t *testing.T) {
+	timeouts := config.TimeoutsConfig{Connect: 5 * time.Second, Read: 30 * time.Second, Write: 30 * time.Second}
+	got, err := withTimeouts("user:pw@tcp(db:3306)/todo?parseTime=true&readTimeout=1m", timeouts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "user:pw@tcp(db:3306)/todo?parseTime=true&readTimeout=1m0s&timeout=5s&writeTimeout=30s"
+	if got != want {
+		t.Errorf("withTimeouts = %s, want %s", got, want)
+	}
+}
+
+func TestPostgresDSN(t *testing.T) {
+	for _, tt := range []struct{ dsn, want string }{
+		{"postgres://u:pw@db:5432/todo?sslmode=disable", "postgres://u:pw@db:5432/todo?connect_timeout=5&sslmode=disable"},
+		{"postgres://u:pw@db:5432/todo?connect_timeout=1", "postgres://u:pw@db:5432/todo?connect_timeout=1"},
+		{"host=db dbname=todo", "host=db dbname=todo connect_timeout=5"},
+		{"host=db connect_timeout=1", "host=db connect_timeout=1"},
+	} {
+		got, err := postgresDSN(tt.dsn, 5*time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("postgresDSN(%q) = %s, want %s", tt.dsn, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/gen/proto/todo/v1/v1connect"
+	"github.com/kogamitora/todo/internal/repository"
+	"github.com/kogamitora/todo/internal/service"
+)
+
+// downloadChunkSize is the size of the chunks sent by DownloadAttachment.
+const downloadChunkSize = 64 * 1024
+
+// AttachmentService
+type AttachmentHandler struct {
+	svc *service.AttachmentService
+}
+
+var _ v1connect.AttachmentServiceHandler = (*AttachmentHandler)(nil)
+
+func NewAttachmentHandler(svc *service.AttachmentService) *AttachmentHandler {
+	return &AttachmentHandler{svc: svc}
+}
+
+// attachmentToProto converts a stored Attachment to a protobuf Attachment message.
+func attachmentToProto(a *repository.Attachment) *todov1.Attachment {
+	return &todov1.Attachment{
+		Id:          a.ID,
+		TodoId:      a.TodoID,
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Sha256:      a.Sha256,
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+	}
+}
+
+// chunkReader reads the chunks of an upload stream.
+type chunkReader struct {
+	stream *connect.ClientStream[todov1.UploadAttachmentRequest]
+	chunk  []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		if !r.stream.Receive() {
+			if err := r.stream.Err(); err != nil {
+				return 0, err
+			}
+			return 0, io.EOF
+		}
+		r.chunk = r.stream.Msg().GetChunk()
+	}
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
+func (h *AttachmentHandler) UploadAttachment(ctx context.Context, stream *connect.ClientStream[todov1.UploadAttachmentRequest]) (*connect.Response[todov1.UploadAttachmentResponse], error) {
+	if !stream.Receive() {
+		if err := stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("empty upload"))
+	}
+	info := stream.Msg().GetInfo()
+	if info == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("first message must contain the attachment info"))
+	}
+
+	attachment, err := h.svc.Upload(ctx, service.UploadParams{
+		TodoID:      info.TodoId,
+		Filename:    info.Filename,
+		ContentType: info.ContentType,
+	}, &chunkReader{stream: stream})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&todov1.UploadAttachmentResponse{
+		Attachment: attachmentToProto(attachment),
+	}), nil
+}
+
+func (h *AttachmentHandler) DownloadAttachment(ctx context.Context, req *connect.Request[todov1.DownloadAttachmentRequest], stream *connect.ServerStream[todov1.DownloadAttachmentResponse]) error {
+	attachment, blob, err := h.svc.Open(ctx, req.Msg.Id)
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+
+	if err := stream.Send(&todov1.DownloadAttachmentResponse{
+		Payload: &todov1.DownloadAttachmentResponse_Attachment{Attachment: attachmentToProto(attachment)},
+	}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := blob.Read(buf)
+		if n > 0 {
+			if sendErr := stream.Send(&todov1.DownloadAttachmentResponse{
+				Payload: &todov1.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			}); sendErr != nil {
+				return sendErr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (h *AttachmentHandler) ListAttachments(ctx context.Context, req *connect.Request[todov1.ListAttachmentsRequest]) (*connect.Response[todov1.ListAttachmentsResponse], error) {
+	attachments, err := h.svc.List(ctx, req.Msg.TodoId)
+	if err != nil {
+		return nil, err
+	}
+
+	protoAttachments := make([]*todov1.Attachment, len(attachments))
+	for i, a := range attachments {
+		protoAttachments[i] = attachmentToProto(a)
+	}
+	return connect.NewResponse(&todov1.ListAttachmentsResponse{
+		Attachments: protoAttachments,
+	}), nil
+}
@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/gen/proto/todo/v1/v1connect"
+	"github.com/kogamitora/todo/internal/repository"
+	"github.com/kogamitora/todo/internal/repository/memory"
+	"github.com/kogamitora/todo/internal/service"
+	"github.com/kogamitora/todo/internal/storage"
+	"github.com/kogamitora/todo/internal/validation"
+)
+
+// countingStore counts the blobs written to a BlobStore.
+type countingStore struct {
+	storage.BlobStore
+	puts int
+}
+
+func (s *countingStore) Put(ctx context.Context, key string, r io.Reader, size int64) error {
+	s.puts++
+	return s.BlobStore.Put(ctx, key, r, size)
+}
+
+// newAttachmentClient serves an AttachmentHandler that accepts attachments
+// of up to maxSize bytes, behind the validation interceptor, and returns a
+// client of it.
+func newAttachmentClient(t *testing.T, maxSize int64) (v1connect.AttachmentServiceClient, repository.Repositories, *countingStore) {
+	t.Helper()
+	local, err := storage.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := &countingStore{BlobStore: local}
+	repos := memory.NewRepositories()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	validator, err := validation.NewInterceptor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle(v1connect.NewAttachmentServiceHandler(
+		NewAttachmentHandler(service.NewAttachmentService(repos.Attachments, repos.Todos, store, maxSize, logger)),
+		connect.WithInterceptors(validator),
+	))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return v1connect.NewAttachmentServiceClient(srv.Client(), srv.URL), repos, store
+}
+
+func upload(ctx context.Context, client v1connect.AttachmentServiceClient, todoID int64, filename string, chunks ...string) (*todov1.Attachment, error) {
+	stream := client.UploadAttachment(ctx)
+	// a send fails once the server has answered, which CloseAndReceive returns
+	err := stream.Send(&todov1.UploadAttachmentRequest{
+		Payload: &todov1.UploadAttachmentRequest_Info{Info: &todov1.UploadAttachmentInfo{TodoId: todoID, Filename: filename}},
+	})
+	for _, chunk := range chunks {
+		if err != nil {
+			break
+		}
+		err = stream.Send(&todov1.UploadAttachmentRequest{
+			Payload: &todov1.UploadAttachmentRequest_Chunk{Chunk: []byte(chunk)},
+		})
+	}
+	res, err := stream.CloseAndReceive()
+	if err != nil {
+		return nil, err
+	}
+	return res.Msg.Attachment, nil
+}
+
+func TestUploadStoresIdenticalContentsOnce(t *testing.T) {
+	ctx := context.Background()
+	client, repos, store := newAttachmentClient(t, 1024)
+	todo := &repository.Todo{Title: "a", Status: todov1.Status_STATUS_INCOMPLETE}
+	if err := repos.Todos.Create(ctx, todo); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := upload(ctx, client, todo.ID, "a.txt", "hello, ", "world")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := upload(ctx, client, todo.ID, "dir/b.txt", "hello, world")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256([]byte("hello, world"))
+	if want := hex.EncodeToString(sum[:]); a.Sha256 != want || b.Sha256 != want {
+		t.Errorf("sha256 = %s, %s, want %s", a.Sha256, b.Sha256, want)
+	}
+	if a.Id == b.Id || b.Filename != "b.txt" || b.ContentType != "application/octet-stream" {
+		t.Errorf("second attachment = %v", b)
+	}
+	if store.puts != 1 {
+		t.Errorf("blobs written = %d, want 1 for identical contents", store.puts)
+	}
+
+	if _, err := upload(ctx, client, todo.ID, "c.txt", "other"); err != nil {
+		t.Fatal(err)
+	}
+	if store.puts != 2 {
+		t.Errorf("blobs written = %d, want 2", store.puts)
+	}
+}
+
+func TestUploadLimits(t *testing.T) {
+	ctx := context.Background()
+	client, repos, store := newAttachmentClient(t, 8)
+	todo := &repository.Todo{Title: "a", Status: todov1.Status_STATUS_INCOMPLETE}
+	if err := repos.Todos.Create(ctx, todo); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := upload(ctx, client, todo.ID, "full.txt", "12345678"); err != nil {
+		t.Fatalf("upload of the maximum size: %v", err)
+	}
+	_, err := upload(ctx, client, todo.ID, "big.txt", "12345", "6789")
+	wantCode(t, err, connect.CodeResourceExhausted)
+	if store.puts != 1 {
+		t.Errorf("blobs written = %d, want only the one within the limit", store.puts)
+	}
+	list, err := repos.Attachments.List(ctx, todo.ID)
+	if err != nil || len(list) != 1 {
+		t.Errorf("attachments = %d, %v, want 1", len(list), err)
+	}
+
+	_, err = upload(ctx, client, todo.ID+1, "a.txt", "x")
+	wantCode(t, err, connect.CodeNotFound)
+	_, err = upload(ctx, client, todo.ID, "", "x")
+	wantCode(t, err, connect.CodeInvalidArgument)
+}
+
+func TestUploadRejectsLongFilename(t *testing.T) {
+	ctx := context.Background()
+	client, repos, store := newAttachmentClient(t, 1024)
+	todo := &repository.Todo{Title: "a", Status: todov1.Status_STATUS_INCOMPLETE}
+	if err := repos.Todos.Create(ctx, todo); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := upload(ctx, client, todo.ID, strings.Repeat("a", 252)+".txt", "x")
+	wantCode(t, err, connect.CodeInvalidArgument)
+	_, err = upload(ctx, client, 0, "a.txt", "x")
+	wantCode(t, err, connect.CodeInvalidArgument)
+	if store.puts != 0 {
+		t.Errorf("blobs written = %d, want none for rejected uploads", store.puts)
+	}
+	if _, err := upload(ctx, client, todo.ID, strings.Repeat("a", 251)+".txt", "x"); err != nil {
+		t.Errorf("upload with a filename of 255 characters: %v", err)
+	}
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/kogamitora/todo/internal/repository/repositorytest"
+	"github.com/kogamitora/todo/internal/service"
+)
+
+// handlers are the handlers under test, sharing one database.
+type handlers struct {
+	todos    *TodoHandler
+	comments *CommentHandler
+}
+
+// forEachBackend runs test once per backend, with fresh handlers.
+func forEachBackend(t *testing.T, test func(t *testing.T, h handlers)) {
+	for _, b := range repositorytest.Backends(t) {
+		t.Run(b.Name, func(t *testing.T) {
+			logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+			repos := b.Open(t)
+			svc := service.NewTodoService(repos.Todos, repos.Dependencies, logger, service.DefaultTransitions(), 0)
+			test(t, handlers{
+				todos:    NewTodoHandler(svc),
+				comments: NewCommentHandler(service.NewCommentService(repos.Comments, repos.Todos, logger)),
+			})
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/gen/proto/todo/v1/v1connect"
+	"github.com/kogamitora/todo/internal/logging"
+	"github.com/kogamitora/todo/internal/repository"
+	"github.com/kogamitora/todo/internal/service"
+)
+
+// CommentService
+type CommentHandler struct {
+	svc *service.CommentService
+}
+
+var _ v1connect.CommentServiceHandler = (*CommentHandler)(nil)
+
+func NewCommentHandler(svc *service.CommentService) *CommentHandler {
+	return &CommentHandler{svc: svc}
+}
+
+// commentToProto converts a stored Comment to a protobuf Comment message.
+func commentToProto(c *repository.Comment) *todov1.Comment {
+	return &todov1.Comment{
+		Id:        c.ID,
+		TodoId:    c.TodoID,
+		Author:    c.Author,
+		Body:      c.Body,
+		CreatedAt: timestamppb.New(c.CreatedAt),
+		UpdatedAt: timestamppb.New(c.UpdatedAt),
+	}
+}
+
+func (h *CommentHandler) AddComment(ctx context.Context, req *connect.Request[todov1.AddCommentRequest]) (*connect.Response[todov1.AddCommentResponse], error) {
+	// the author is the calling user; the deprecated author field is ignored
+	comment, err := h.svc.Add(ctx, req.Msg.TodoId, req.Header().Get(logging.UserHeader), req.Msg.Body)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&todov1.AddCommentResponse{
+		Comment: commentToProto(comment),
+	}), nil
+}
+
+func (h *CommentHandler) UpdateComment(ctx context.Context, req *connect.Request[todov1.UpdateCommentRequest]) (*connect.Response[todov1.UpdateCommentResponse], error) {
+	comment, err := h.svc.Update(ctx, req.Msg.Id, req.Header().Get(logging.UserHeader), req.Msg.Body)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&todov1.UpdateCommentResponse{
+		Comment: commentToProto(comment),
+	}), nil
+}
+
+func (h *CommentHandler) DeleteComment(ctx context.Context, req *connect.Request[todov1.DeleteCommentRequest]) (*connect.Response[todov1.DeleteCommentResponse], error) {
+	if err := h.svc.Delete(ctx, req.Msg.Id, req.Header().Get(logging.UserHeader)); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&todov1.DeleteCommentResponse{}), nil
+}
+
+func (h *CommentHandler) ListComments(ctx context.Context, req *connect.Request[todov1.ListCommentsRequest]) (*connect.Response[todov1.ListCommentsResponse], error) {
+	var limit *int
+	if req.Msg.Limit != nil {
+		n := int(*req.Msg.Limit)
+		limit = &n
+	}
+
+	comments, err := h.svc.List(ctx, req.Msg.TodoId, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	protoComments := make([]*todov1.Comment, len(comments))
+	for i, c := range comments {
+		protoComments[i] = commentToProto(c)
+	}
+	return connect.NewResponse(&todov1.ListCommentsResponse{
+		Comments: protoComments,
+	}), nil
+}
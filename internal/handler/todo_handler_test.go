@@ -0,0 +1,283 @@
+package handler
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/internal/logging"
+)
+
+func createTodo(t *testing.T, h handlers, title string, due time.Time) *todov1.Todo {
+	t.Helper()
+	req := &todov1.CreateTodoRequest{Title: title}
+	if !due.IsZero() {
+		req.DueDate = timestamppb.New(due)
+	}
+	res, err := h.todos.CreateTodo(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		t.Fatalf("CreateTodo(%q): %v", title, err)
+	}
+	return res.Msg.Todo
+}
+
+func listTitles(t *testing.T, h handlers, req *todov1.GetTodosRequest) []string {
+	t.Helper()
+	res, err := h.todos.GetTodos(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		t.Fatalf("GetTodos: %v", err)
+	}
+	var titles []string
+	for _, todo := range res.Msg.Todos {
+		titles = append(titles, todo.Title)
+	}
+	return titles
+}
+
+func updateStatus(ctx context.Context, h handlers, id int64, status todov1.Status) (*todov1.Todo, error) {
+	res, err := h.todos.UpdateTodo(ctx, connect.NewRequest(&todov1.UpdateTodoRequest{Id: id, Status: &status}))
+	if err != nil {
+		return nil, err
+	}
+	return res.Msg.Todo, nil
+}
+
+func wantCode(t *testing.T, err error, code connect.Code) {
+	t.Helper()
+	if got := connect.CodeOf(err); err == nil || got != code {
+		t.Fatalf("error = %v, want code %s", err, code)
+	}
+}
+
+func TestTodoCRUD(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, h handlers) {
+		ctx := context.Background()
+		due := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+		created := createTodo(t, h, "write tests", due)
+
+		got, err := h.todos.GetTodo(ctx, connect.NewRequest(&todov1.GetTodoRequest{Id: created.Id}))
+		if err != nil {
+			t.Fatalf("GetTodo: %v", err)
+		}
+		todo := got.Msg.Todo
+		if todo.Title != "write tests" || todo.Status != todov1.Status_STATUS_INCOMPLETE || !todo.DueDate.AsTime().Equal(due) {
+			t.Errorf("GetTodo = %v", todo)
+		}
+
+		updated, err := h.todos.UpdateTodo(ctx, connect.NewRequest(&todov1.UpdateTodoRequest{
+			Id:          created.Id,
+			Title:       proto.String("write more tests"),
+			Description: proto.String("for every backend"),
+		}))
+		if err != nil {
+			t.Fatalf("UpdateTodo: %v", err)
+		}
+		if updated.Msg.Todo.Title != "write more tests" || updated.Msg.Todo.Description != "for every backend" {
+			t.Errorf("UpdateTodo = %v", updated.Msg.Todo)
+		}
+
+		if _, err := h.todos.DeleteTodo(ctx, connect.NewRequest(&todov1.DeleteTodoRequest{Id: created.Id})); err != nil {
+			t.Fatalf("DeleteTodo: %v", err)
+		}
+		if titles := listTitles(t, h, &todov1.GetTodosRequest{}); len(titles) != 0 {
+			t.Errorf("deleted todo is listed: %v", titles)
+		}
+		// a deleted todo can still be read by ID
+		if _, err := h.todos.GetTodo(ctx, connect.NewRequest(&todov1.GetTodoRequest{Id: created.Id})); err != nil {
+			t.Errorf("GetTodo after delete: %v", err)
+		}
+
+		_, err = h.todos.GetTodo(ctx, connect.NewRequest(&todov1.GetTodoRequest{Id: created.Id + 100}))
+		wantCode(t, err, connect.CodeNotFound)
+	})
+}
+
+func TestGetTodosOrderAndFilter(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, h handlers) {
+		ctx := context.Background()
+		day := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+		createTodo(t, h, "none", time.Time{})
+		late := createTodo(t, h, "late", day.AddDate(0, 0, 2))
+		createTodo(t, h, "early", day)
+
+		asc, desc := todov1.SortOrder_SORT_ORDER_ASC, todov1.SortOrder_SORT_ORDER_DESC
+		if got, want := listTitles(t, h, &todov1.GetTodosRequest{SortByDueDate: &asc}), []string{"early", "late", "none"}; !slices.Equal(got, want) {
+			t.Errorf("due date ascending = %v, want %v", got, want)
+		}
+		if got, want := listTitles(t, h, &todov1.GetTodosRequest{SortByDueDate: &desc}), []string{"none", "late", "early"}; !slices.Equal(got, want) {
+			t.Errorf("due date descending = %v, want %v", got, want)
+		}
+
+		if _, err := updateStatus(ctx, h, late.Id, todov1.Status_STATUS_COMPLETED); err != nil {
+			t.Fatal(err)
+		}
+		completed := todov1.Status_STATUS_COMPLETED
+		if got, want := listTitles(t, h, &todov1.GetTodosRequest{StatusFilter: &completed}), []string{"late"}; !slices.Equal(got, want) {
+			t.Errorf("completed = %v, want %v", got, want)
+		}
+
+		manual := todov1.Sort_SORT_MANUAL
+		_, err := h.todos.GetTodos(ctx, connect.NewRequest(&todov1.GetTodosRequest{Sort: &manual, SortByDueDate: &asc}))
+		wantCode(t, err, connect.CodeInvalidArgument)
+	})
+}
+
+func TestDependencies(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, h handlers) {
+		ctx := context.Background()
+		a := createTodo(t, h, "a", time.Time{})
+		b := createTodo(t, h, "b", time.Time{})
+
+		res, err := h.todos.AddDependency(ctx, connect.NewRequest(&todov1.AddDependencyRequest{TodoId: a.Id, BlockedById: b.Id}))
+		if err != nil {
+			t.Fatalf("AddDependency: %v", err)
+		}
+		if !slices.Equal(res.Msg.Todo.BlockedBy, []int64{b.Id}) {
+			t.Errorf("blocked by %v, want [%d]", res.Msg.Todo.BlockedBy, b.Id)
+		}
+		_, err = h.todos.AddDependency(ctx, connect.NewRequest(&todov1.AddDependencyRequest{TodoId: a.Id, BlockedById: b.Id}))
+		wantCode(t, err, connect.CodeAlreadyExists)
+		_, err = h.todos.AddDependency(ctx, connect.NewRequest(&todov1.AddDependencyRequest{TodoId: b.Id, BlockedById: a.Id}))
+		wantCode(t, err, connect.CodeFailedPrecondition)
+
+		blocked, ready := todov1.DependencyFilter_DEPENDENCY_FILTER_BLOCKED, todov1.DependencyFilter_DEPENDENCY_FILTER_READY
+		if got := listTitles(t, h, &todov1.GetTodosRequest{DependencyFilter: &blocked}); !slices.Equal(got, []string{"a"}) {
+			t.Errorf("blocked = %v, want [a]", got)
+		}
+		_, err = updateStatus(ctx, h, a.Id, todov1.Status_STATUS_COMPLETED)
+		wantCode(t, err, connect.CodeFailedPrecondition)
+
+		if _, err := updateStatus(ctx, h, b.Id, todov1.Status_STATUS_COMPLETED); err != nil {
+			t.Fatal(err)
+		}
+		if got := listTitles(t, h, &todov1.GetTodosRequest{DependencyFilter: &ready}); len(got) != 2 {
+			t.Errorf("ready = %v, want both todos", got)
+		}
+		if _, err := updateStatus(ctx, h, a.Id, todov1.Status_STATUS_COMPLETED); err != nil {
+			t.Errorf("complete after blocker: %v", err)
+		}
+
+		if _, err := h.todos.RemoveDependency(ctx, connect.NewRequest(&todov1.RemoveDependencyRequest{TodoId: a.Id, BlockedById: b.Id})); err != nil {
+			t.Fatalf("RemoveDependency: %v", err)
+		}
+		_, err = h.todos.RemoveDependency(ctx, connect.NewRequest(&todov1.RemoveDependencyRequest{TodoId: a.Id, BlockedById: b.Id}))
+		wantCode(t, err, connect.CodeNotFound)
+	})
+}
+
+func TestMoveTodo(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, h handlers) {
+		ctx := context.Background()
+		a := createTodo(t, h, "a", time.Time{})
+		createTodo(t, h, "b", time.Time{})
+		c := createTodo(t, h, "c", time.Time{})
+
+		if _, err := h.todos.MoveTodo(ctx, connect.NewRequest(&todov1.MoveTodoRequest{Id: c.Id, BeforeId: &a.Id})); err != nil {
+			t.Fatalf("MoveTodo: %v", err)
+		}
+		manual := todov1.Sort_SORT_MANUAL
+		if got, want := listTitles(t, h, &todov1.GetTodosRequest{Sort: &manual}), []string{"c", "a", "b"}; !slices.Equal(got, want) {
+			t.Errorf("manual order = %v, want %v", got, want)
+		}
+	})
+}
+
+func addComment(ctx context.Context, h handlers, user string, msg *todov1.AddCommentRequest) (*todov1.Comment, error) {
+	req := connect.NewRequest(msg)
+	if user != "" {
+		req.Header().Set(logging.UserHeader, user)
+	}
+	res, err := h.comments.AddComment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return res.Msg.Comment, nil
+}
+
+func TestComments(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, h handlers) {
+		ctx := context.Background()
+		todo := createTodo(t, h, "a", time.Time{})
+
+		var ids []int64
+		for _, body := range []string{"first", "second", "third"} {
+			c, err := addComment(ctx, h, "alice", &todov1.AddCommentRequest{TodoId: todo.Id, Body: body})
+			if err != nil {
+				t.Fatalf("AddComment: %v", err)
+			}
+			ids = append(ids, c.Id)
+		}
+		list := func(limit *int32) []string {
+			t.Helper()
+			res, err := h.comments.ListComments(ctx, connect.NewRequest(&todov1.ListCommentsRequest{TodoId: todo.Id, Limit: limit}))
+			if err != nil {
+				t.Fatalf("ListComments: %v", err)
+			}
+			var bodies []string
+			for _, c := range res.Msg.Comments {
+				bodies = append(bodies, c.Body)
+			}
+			return bodies
+		}
+		if got, want := list(nil), []string{"first", "second", "third"}; !slices.Equal(got, want) {
+			t.Errorf("comments = %v, want %v", got, want)
+		}
+		// the latest comments, still oldest first
+		if got, want := list(proto.Int32(2)), []string{"second", "third"}; !slices.Equal(got, want) {
+			t.Errorf("comments with limit 2 = %v, want %v", got, want)
+		}
+
+		asAlice := func(req connect.AnyRequest) {
+			req.Header().Set(logging.UserHeader, "alice")
+		}
+		del := connect.NewRequest(&todov1.DeleteCommentRequest{Id: ids[1]})
+		asAlice(del)
+		if _, err := h.comments.DeleteComment(ctx, del); err != nil {
+			t.Fatalf("DeleteComment: %v", err)
+		}
+		if got, want := list(nil), []string{"first", "third"}; !slices.Equal(got, want) {
+			t.Errorf("comments after a delete = %v, want %v", got, want)
+		}
+		_, err := h.comments.DeleteComment(ctx, del)
+		wantCode(t, err, connect.CodeNotFound)
+		update := connect.NewRequest(&todov1.UpdateCommentRequest{Id: ids[1], Body: "edited"})
+		asAlice(update)
+		_, err = h.comments.UpdateComment(ctx, update)
+		wantCode(t, err, connect.CodeNotFound)
+	})
+}
+
+func TestCommentAuthor(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, h handlers) {
+		ctx := context.Background()
+		todo := createTodo(t, h, "a", time.Time{})
+
+		// the author in the request body is ignored
+		c, err := addComment(ctx, h, "alice", &todov1.AddCommentRequest{TodoId: todo.Id, Author: "mallory", Body: "hi"})
+		if err != nil {
+			t.Fatalf("AddComment: %v", err)
+		}
+		if c.Author != "alice" {
+			t.Errorf("author = %q, want the X-User alice", c.Author)
+		}
+
+		_, err = addComment(ctx, h, "", &todov1.AddCommentRequest{TodoId: todo.Id, Author: "mallory", Body: "hi"})
+		wantCode(t, err, connect.CodeUnauthenticated)
+
+		// only the author may change it
+		update := connect.NewRequest(&todov1.UpdateCommentRequest{Id: c.Id, Body: "rewritten"})
+		update.Header().Set(logging.UserHeader, "mallory")
+		_, err = h.comments.UpdateComment(ctx, update)
+		wantCode(t, err, connect.CodePermissionDenied)
+		del := connect.NewRequest(&todov1.DeleteCommentRequest{Id: c.Id})
+		del.Header().Set(logging.UserHeader, "mallory")
+		_, err = h.comments.DeleteComment(ctx, del)
+		wantCode(t, err, connect.CodePermissionDenied)
+	})
+}
@@ -0,0 +1,119 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/grpchealth"
+
+	"github.com/kogamitora/todo/internal/db"
+)
+
+// checkTimeout bounds a single readiness check.
+const checkTimeout = 2 * time.Second
+
+// Checker reports whether the server can serve traffic: the database must
+// answer a ping and its schema must be at the version the binary was built
+// for.
+// Without a database, as with the in-memory store, it is always ready.
+// It implements grpchealth.Checker for the grpc.health.v1 service.
+type Checker struct {
+	db              *sql.DB
+	expectedVersion uint
+	services        []string
+	logger          *slog.Logger
+}
+
+var _ grpchealth.Checker = (*Checker)(nil)
+
+func NewChecker(database *sql.DB, expectedVersion uint, logger *slog.Logger, services ...string) *Checker {
+	return &Checker{
+		db:              database,
+		expectedVersion: expectedVersion,
+		services:        services,
+		logger:          logger,
+	}
+}
+
+// Ready returns nil if the database is reachable and fully migrated.
+func (c *Checker) Ready(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	if c.db == nil {
+		return nil
+	}
+	if err := c.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("database unreachable: %w", err)
+	}
+	version, dirty, err := db.SchemaVersion(ctx, c.db)
+	if err != nil {
+		return err
+	}
+	if dirty {
+		return fmt.Errorf("schema version %d is dirty", version)
+	}
+	if version != c.expectedVersion {
+		return fmt.Errorf("schema version is %d, expected %d", version, c.expectedVersion)
+	}
+	return nil
+}
+
+// Check implements grpchealth.Checker. The whole process and every
+// registered service share the readiness status.
+func (c *Checker) Check(ctx context.Context, req *grpchealth.CheckRequest) (*grpchealth.CheckResponse, error) {
+	if req.Service != "" && !slices.Contains(c.services, req.Service) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("unknown service %s", req.Service))
+	}
+	if !c.ready(ctx) {
+		return &grpchealth.CheckResponse{Status: grpchealth.StatusNotServing}, nil
+	}
+	return &grpchealth.CheckResponse{Status: grpchealth.StatusServing}, nil
+}
+
+// LivenessHandler answers /healthz. It only shows that the process is able
+// to serve HTTP, so it never touches the database.
+func LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeStatus(w, http.StatusOK, "ok", "")
+	})
+}
+
+// ready calls Ready and logs why it failed, which the unauthenticated
+// probes are not told.
+func (c *Checker) ready(ctx context.Context) bool {
+	if err := c.Ready(ctx); err != nil {
+		c.logger.WarnContext(ctx, "not ready", "error", err)
+		return false
+	}
+	return true
+}
+
+// ReadinessHandler answers /readyz with 503 while Ready fails. The body
+// does not say why, as the error may reveal details of the database.
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !c.ready(r.Context()) {
+			writeStatus(w, http.StatusServiceUnavailable, "unavailable", "database unavailable")
+			return
+		}
+		writeStatus(w, http.StatusOK, "ok", "")
+	})
+}
+
+func writeStatus(w http.ResponseWriter, code int, status, reason string) {
+	body := map[string]string{"status": status}
+	if reason != "" {
+		body["error"] = reason
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(body)
+}
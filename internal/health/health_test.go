@@ -0,0 +1,49 @@
+package health
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadinessHandlerHidesErrors(t *testing.T) {
+	database, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "todo.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	database.Close()
+
+	var logs bytes.Buffer
+	checker := NewChecker(database, 1, slog.New(slog.NewTextHandler(&logs, nil)))
+	rec := httptest.NewRecorder()
+	checker.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want 503", rec.Code)
+	}
+	var body map[string]string
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if body["status"] != "unavailable" || body["error"] != "database unavailable" {
+		t.Errorf("body = %v, want a generic error", body)
+	}
+	if !strings.Contains(logs.String(), "database is closed") {
+		t.Errorf("logs = %q, want the cause", logs.String())
+	}
+}
+
+func TestReadinessHandlerWithoutDatabase(t *testing.T) {
+	checker := NewChecker(nil, 1, slog.Default())
+	rec := httptest.NewRecorder()
+	checker.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"ok"`) {
+		t.Errorf("response = %d %s, want 200 ok", rec.Code, rec.Body)
+	}
+}
@@ -0,0 +1,118 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+const (
+	// RequestIDHeader carries the request ID. A value sent by the client is
+	// kept, otherwise one is generated; it is echoed in the response.
+	RequestIDHeader = "X-Request-Id"
+	// UserHeader names the calling user. todocli sends the OS user name.
+	UserHeader = "X-User"
+
+	maxRequestIDLen = 128
+)
+
+// NewInterceptor logs one record per call with its procedure, duration,
+// status code, request ID, user and peer, and gives the handler a logger
+// carrying the same request attributes through FromContext.
+func NewInterceptor(logger *slog.Logger) connect.Interceptor {
+	return &interceptor{logger: logger}
+}
+
+type interceptor struct {
+	logger *slog.Logger
+}
+
+func (i *interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		requestID := requestIDFrom(req.Header())
+		ctx, reqLogger := i.begin(ctx, req.Spec().Procedure, requestID, req.Header(), req.Peer())
+
+		start := time.Now()
+		res, err := next(ctx, req)
+		if err == nil {
+			res.Header().Set(RequestIDHeader, requestID)
+		} else if cerr := new(connect.Error); errors.As(err, &cerr) {
+			cerr.Meta().Set(RequestIDHeader, requestID)
+		}
+		i.end(ctx, reqLogger, start, err)
+		return res, err
+	}
+}
+
+func (i *interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		requestID := requestIDFrom(conn.RequestHeader())
+		conn.ResponseHeader().Set(RequestIDHeader, requestID)
+		ctx, reqLogger := i.begin(ctx, conn.Spec().Procedure, requestID, conn.RequestHeader(), conn.Peer())
+
+		start := time.Now()
+		err := next(ctx, conn)
+		i.end(ctx, reqLogger, start, err)
+		return err
+	}
+}
+
+// begin derives the request-scoped logger and stores it in the context.
+func (i *interceptor) begin(ctx context.Context, procedure, requestID string, header http.Header, peer connect.Peer) (context.Context, *slog.Logger) {
+	reqLogger := i.logger.With(
+		"procedure", procedure,
+		"request_id", requestID,
+		"user", header.Get(UserHeader),
+		"peer", peer.Addr,
+	)
+	return WithLogger(ctx, reqLogger), reqLogger
+}
+
+// end writes the per-call record. Errors on the server side are logged at
+// Error, everything else at Info.
+func (i *interceptor) end(ctx context.Context, reqLogger *slog.Logger, start time.Time, err error) {
+	code := "ok"
+	level := slog.LevelInfo
+	if err != nil {
+		c := connect.CodeOf(err)
+		code = c.String()
+		if isServerError(c) {
+			level = slog.LevelError
+		}
+	}
+	attrs := []any{"duration", time.Since(start), "code", code}
+	if err != nil {
+		attrs = append(attrs, "error", err)
+	}
+	reqLogger.Log(ctx, level, "rpc finished", attrs...)
+}
+
+func isServerError(c connect.Code) bool {
+	switch c {
+	case connect.CodeInternal, connect.CodeUnknown, connect.CodeDataLoss, connect.CodeUnavailable, connect.CodeUnimplemented:
+		return true
+	}
+	return false
+}
+
+func requestIDFrom(header http.Header) string {
+	if id := header.Get(RequestIDHeader); id != "" && len(id) <= maxRequestIDLen {
+		return id
+	}
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,88 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/gen/proto/todo/v1/v1connect"
+	"github.com/kogamitora/todo/internal/config"
+)
+
+type getTodoHandler struct {
+	v1connect.UnimplementedTodoServiceHandler
+}
+
+func (getTodoHandler) GetTodo(ctx context.Context, req *connect.Request[todov1.GetTodoRequest]) (*connect.Response[todov1.GetTodoResponse], error) {
+	FromContext(ctx, nil).InfoContext(ctx, "looking up todo")
+	return connect.NewResponse(&todov1.GetTodoResponse{Todo: &todov1.Todo{Id: req.Msg.Id}}), nil
+}
+
+func TestInterceptorLogsOneRecordPerCall(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(config.LogConfig{Level: "info", Format: "json"}, new(slog.LevelVar), &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(v1connect.NewTodoServiceHandler(getTodoHandler{}, connect.WithInterceptors(NewInterceptor(logger))))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	client := v1connect.NewTodoServiceClient(srv.Client(), srv.URL)
+
+	req := connect.NewRequest(&todov1.GetTodoRequest{Id: 1})
+	req.Header().Set(RequestIDHeader, "req-1")
+	req.Header().Set(UserHeader, "alice")
+	res, err := client.GetTodo(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := res.Header().Get(RequestIDHeader); got != "req-1" {
+		t.Fatalf("response request ID = %q, want %q", got, "req-1")
+	}
+
+	_, err = client.DeleteTodo(context.Background(), connect.NewRequest(&todov1.DeleteTodoRequest{Id: 1}))
+	if connect.CodeOf(err) != connect.CodeUnimplemented {
+		t.Fatalf("DeleteTodo error = %v, want unimplemented", err)
+	}
+	var cerr *connect.Error
+	if !errors.As(err, &cerr) || cerr.Meta().Get(RequestIDHeader) == "" {
+		t.Fatalf("error metadata has no request ID: %v", err)
+	}
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var r map[string]any
+		if err := json.Unmarshal([]byte(line), &r); err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, r)
+	}
+	if len(records) != 3 {
+		t.Fatalf("got %d records, want 3:\n%s", len(records), buf.String())
+	}
+
+	handlerLog, getLog, deleteLog := records[0], records[1], records[2]
+	if handlerLog["msg"] != "looking up todo" || handlerLog["request_id"] != "req-1" || handlerLog["user"] != "alice" {
+		t.Errorf("handler record lacks the request attributes: %v", handlerLog)
+	}
+	if getLog["procedure"] != v1connect.TodoServiceGetTodoProcedure || getLog["code"] != "ok" || getLog["level"] != "INFO" {
+		t.Errorf("unexpected GetTodo record: %v", getLog)
+	}
+	if _, ok := getLog["duration"]; !ok {
+		t.Errorf("GetTodo record has no duration: %v", getLog)
+	}
+	if deleteLog["code"] != "unimplemented" || deleteLog["level"] != "ERROR" {
+		t.Errorf("unexpected DeleteTodo record: %v", deleteLog)
+	}
+}
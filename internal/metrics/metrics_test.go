@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestInterceptorCountsRequests(t *testing.T) {
+	const (
+		okProcedure   = "/test.v1.Service/Ok"
+		failProcedure = "/test.v1.Service/Fail"
+	)
+	m := New(nil, "", fakeCounter{})
+
+	ok := func(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+		return connect.NewResponse(&emptypb.Empty{}), nil
+	}
+	fail := func(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("no such todo"))
+	}
+	interceptors := connect.WithInterceptors(m.Interceptor())
+	mux := http.NewServeMux()
+	mux.Handle(okProcedure, connect.NewUnaryHandler(okProcedure, ok, interceptors))
+	mux.Handle(failProcedure, connect.NewUnaryHandler(failProcedure, fail, interceptors))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	call := func(procedure string) {
+		client := connect.NewClient[emptypb.Empty, emptypb.Empty](srv.Client(), srv.URL+procedure)
+		client.CallUnary(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	}
+	call(okProcedure)
+	call(okProcedure)
+	call(failProcedure)
+
+	for _, tt := range []struct {
+		procedure, code string
+		want            float64
+	}{
+		{okProcedure, "ok", 2},
+		{failProcedure, "not_found", 1},
+		{okProcedure, "not_found", 0},
+	} {
+		got := testutil.ToFloat64(m.requests.WithLabelValues(tt.procedure, tt.code))
+		if got != tt.want {
+			t.Errorf("requests{procedure=%q, code=%q} = %v, want %v", tt.procedure, tt.code, got, tt.want)
+		}
+	}
+	if n := testutil.CollectAndCount(m.duration); n != 2 {
+		t.Errorf("%d duration series, want one per procedure", n)
+	}
+}
@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// scrapeTimeout bounds the queries run for a single scrape.
+const scrapeTimeout = 5 * time.Second
+
+// TodoCounter counts the todos that are neither deleted, completed nor
+// cancelled, and how many of them were due before now. It is implemented
+// by the todo repositories.
+type TodoCounter interface {
+	CountOpen(ctx context.Context, now time.Time) (open, overdue int64, err error)
+}
+
+// todoCollector reports the number of open and overdue todos. The counts are
+// queried on each scrape, so they are always current.
+type todoCollector struct {
+	todos TodoCounter
+
+	open        *prometheus.Desc
+	overdue     *prometheus.Desc
+	scrapeError *prometheus.Desc
+}
+
+func newTodoCollector(todos TodoCounter) *todoCollector {
+	return &todoCollector{
+		todos: todos,
+		open: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "todos", "open"),
+			"Number of todos that are neither completed, cancelled nor deleted.",
+			nil, nil,
+		),
+		overdue: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "todos", "overdue"),
+			"Number of open todos whose due date has passed.",
+			nil, nil,
+		),
+		scrapeError: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "todos", "scrape_error"),
+			"1 if the todo counts could not be queried on the last scrape.",
+			nil, nil,
+		),
+	}
+}
+
+func (c *todoCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.open
+	ch <- c.overdue
+	ch <- c.scrapeError
+}
+
+func (c *todoCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), scrapeTimeout)
+	defer cancel()
+
+	openCount, overdueCount, err := c.todos.CountOpen(ctx, time.Now())
+	if err != nil {
+		ch <- prometheus.MustNewConstMetric(c.scrapeError, prometheus.GaugeValue, 1)
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.open, prometheus.GaugeValue, float64(openCount))
+	ch <- prometheus.MustNewConstMetric(c.overdue, prometheus.GaugeValue, float64(overdueCount))
+	ch <- prometheus.MustNewConstMetric(c.scrapeError, prometheus.GaugeValue, 0)
+}
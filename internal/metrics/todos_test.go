@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type fakeCounter struct {
+	open, overdue int64
+	err           error
+}
+
+func (c fakeCounter) CountOpen(context.Context, time.Time) (int64, int64, error) {
+	return c.open, c.overdue, c.err
+}
+
+func TestTodoCollector(t *testing.T) {
+	want := `
+# HELP todo_todos_open Number of todos that are neither completed, cancelled nor deleted.
+# TYPE todo_todos_open gauge
+todo_todos_open 5
+# HELP todo_todos_overdue Number of open todos whose due date has passed.
+# TYPE todo_todos_overdue gauge
+todo_todos_overdue 2
+# HELP todo_todos_scrape_error 1 if the todo counts could not be queried on the last scrape.
+# TYPE todo_todos_scrape_error gauge
+todo_todos_scrape_error 0
+`
+	c := newTodoCollector(fakeCounter{open: 5, overdue: 2})
+	if err := testutil.CollectAndCompare(c, strings.NewReader(want)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTodoCollectorScrapeError(t *testing.T) {
+	want := `
+# HELP todo_todos_scrape_error 1 if the todo counts could not be queried on the last scrape.
+# TYPE todo_todos_scrape_error gauge
+todo_todos_scrape_error 1
+`
+	c := newTodoCollector(fakeCounter{err: errors.New("database is down")})
+	if err := testutil.CollectAndCompare(c, strings.NewReader(want)); err != nil {
+		t.Error(err)
+	}
+	if n := testutil.CollectAndCount(c); n != 1 {
+		t.Errorf("%d metrics, want only the scrape error without counts", n)
+	}
+}
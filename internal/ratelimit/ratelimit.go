@@ -0,0 +1,162 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/time/rate"
+
+	"github.com/kogamitora/todo/internal/apierr"
+)
+
+// APIKeyHeader identifies scripts and services. Nothing verifies it, so,
+// like the user header, it does not choose a bucket: a client could rotate
+// it to get a fresh bucket on every call.
+const APIKeyHeader = "X-Api-Key"
+
+// idleTimeout is how long a bucket may go unused before it is dropped. A
+// dropped bucket comes back full, which is what it would have refilled to.
+const idleTimeout = 10 * time.Minute
+
+// maxBuckets caps the number of clients the limiter tracks. When it is
+// reached, idle buckets are dropped early, and if none is idle new clients
+// are rejected until one is.
+const maxBuckets = 100_000
+
+// Limiter keeps one token bucket per client, refilled at a fixed rate. A
+// call or stream takes one token; a client with an empty bucket gets
+// CodeResourceExhausted until a token is available again.
+type Limiter struct {
+	mu        sync.Mutex
+	limit     rate.Limit // zero disables the limiter
+	burst     int
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// New returns a limiter that allows each client requestsPerSecond calls on
+// average and bursts of up to burst calls. Zero requestsPerSecond lets
+// every call through.
+func New(requestsPerSecond float64, burst int) *Limiter {
+	return &Limiter{
+		limit:   rate.Limit(requestsPerSecond),
+		burst:   burst,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// SetLimits changes the rate and burst of all clients, including those
+// that already have a bucket. The tokens they hold are kept.
+func (l *Limiter) SetLimits(requestsPerSecond float64, burst int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.limit = rate.Limit(requestsPerSecond)
+	l.burst = burst
+	for _, b := range l.buckets {
+		b.limiter.SetLimitAt(now, l.limit)
+		b.limiter.SetBurstAt(now, l.burst)
+	}
+}
+
+// Interceptor rejects calls from clients that exceeded their rate.
+func (l *Limiter) Interceptor() connect.Interceptor {
+	return &interceptor{limiter: l}
+}
+
+// Key returns the bucket of a request: the client IP. The API key and user
+// headers are chosen by the client and never verified, so they are ignored;
+// clients behind the same address share a bucket.
+func Key(_ http.Header, peer connect.Peer) string {
+	host, _, err := net.SplitHostPort(peer.Addr)
+	if err != nil {
+		host = peer.Addr
+	}
+	return "ip:" + host
+}
+
+// reserve takes a token from the bucket of key. If none is available it
+// returns how long the client has to wait for one.
+func (l *Limiter) reserve(key string, now time.Time) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.limit == 0 {
+		return 0, true
+	}
+	if now.Sub(l.lastSweep) >= idleTimeout {
+		l.sweep(now)
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= maxBuckets {
+			l.sweep(now)
+			if len(l.buckets) >= maxBuckets {
+				return time.Second, false
+			}
+		}
+		b = &bucket{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+
+	r := b.limiter.ReserveN(now, 1)
+	if !r.OK() {
+		// burst is zero: nothing is ever allowed
+		return time.Second, false
+	}
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return delay, false
+	}
+	return 0, true
+}
+
+// sweep drops the buckets that have been idle for idleTimeout.
+func (l *Limiter) sweep(now time.Time) {
+	for k, b := range l.buckets {
+		if now.Sub(b.lastSeen) >= idleTimeout {
+			delete(l.buckets, k)
+		}
+	}
+	l.lastSweep = now
+}
+
+type interceptor struct {
+	limiter *Limiter
+}
+
+func (i *interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if !req.Spec().IsClient {
+			if retryAfter, ok := i.limiter.reserve(Key(req.Header(), req.Peer()), time.Now()); !ok {
+				return nil, apierr.RateLimited(retryAfter)
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if retryAfter, ok := i.limiter.reserve(Key(conn.RequestHeader(), conn.Peer()), time.Now()); !ok {
+			return apierr.RateLimited(retryAfter)
+		}
+		return next(ctx, conn)
+	}
+}
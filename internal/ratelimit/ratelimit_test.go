@@ -0,0 +1,117 @@
+package ratelimit
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/time/rate"
+)
+
+func TestLimiterRefillsPerKey(t *testing.T) {
+	l := New(2, 3)
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		if _, ok := l.reserve("user:alice", now); !ok {
+			t.Fatalf("call %d within the burst was rejected", i+1)
+		}
+	}
+	retryAfter, ok := l.reserve("user:alice", now)
+	if ok {
+		t.Fatal("call beyond the burst was allowed")
+	}
+	if retryAfter != 500*time.Millisecond {
+		t.Errorf("retry after = %s, want 500ms at 2 requests per second", retryAfter)
+	}
+	if _, ok := l.reserve("user:bob", now); !ok {
+		t.Error("another client was limited by alice's calls")
+	}
+	if _, ok := l.reserve("user:alice", now.Add(retryAfter)); !ok {
+		t.Error("call after the retry delay was rejected")
+	}
+
+	l.reserve("user:bob", now.Add(2*idleTimeout))
+	if _, ok := l.buckets["user:alice"]; ok {
+		t.Error("idle bucket was not dropped")
+	}
+}
+
+func TestLimiterSetLimits(t *testing.T) {
+	l := New(1, 1)
+	now := time.Now()
+	l.reserve("user:alice", now)
+	if _, ok := l.reserve("user:alice", now); ok {
+		t.Fatal("call beyond the burst was allowed")
+	}
+
+	l.SetLimits(0, 0)
+	if _, ok := l.reserve("user:alice", now); !ok {
+		t.Error("call was rejected with the limiter disabled")
+	}
+
+	l.SetLimits(1, 1)
+	if _, ok := l.reserve("user:alice", now); ok {
+		t.Error("call was allowed after the limiter was enabled again")
+	}
+}
+
+func TestKey(t *testing.T) {
+	peer := connect.Peer{Addr: "192.0.2.1:54321"}
+	tests := []struct {
+		header http.Header
+		want   string
+	}{
+		{http.Header{"X-Api-Key": {"k1"}, "X-User": {"alice"}}, "ip:192.0.2.1"},
+		{http.Header{"X-User": {"alice"}}, "ip:192.0.2.1"},
+		{http.Header{}, "ip:192.0.2.1"},
+	}
+	for _, tt := range tests {
+		if got := Key(tt.header, peer); got != tt.want {
+			t.Errorf("Key(%v) = %q, want %q", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestRotatingHeadersKeepTheLimit(t *testing.T) {
+	l := New(1, 2)
+	now := time.Now()
+	peer := connect.Peer{Addr: "192.0.2.1:54321"}
+
+	for i, user := range []string{"alice", "bob", "carol"} {
+		header := http.Header{"X-Api-Key": {"k-" + user}, "X-User": {user}}
+		_, ok := l.reserve(Key(header, peer), now)
+		if want := i < 2; ok != want {
+			t.Errorf("call %d as %s allowed = %v, want %v", i+1, user, ok, want)
+		}
+	}
+	if len(l.buckets) != 1 {
+		t.Errorf("%d buckets, want 1 for one client address", len(l.buckets))
+	}
+}
+
+func TestLimiterCapsBuckets(t *testing.T) {
+	l := New(1, 1)
+	now := time.Now()
+	for i := 0; i < maxBuckets; i++ {
+		l.buckets[fmt.Sprintf("ip:%d", i)] = &bucket{limiter: rate.NewLimiter(1, 1), lastSeen: now}
+	}
+
+	if _, ok := l.reserve("ip:new", now); ok {
+		t.Error("new client was allowed with every bucket in use")
+	}
+	if len(l.buckets) != maxBuckets {
+		t.Errorf("%d buckets, want at most %d", len(l.buckets), maxBuckets)
+	}
+	if _, ok := l.reserve("ip:0", now); !ok {
+		t.Error("known client was rejected with every bucket in use")
+	}
+
+	// once the buckets go idle they make room, before the periodic sweep
+	l.lastSweep = now.Add(idleTimeout / 2)
+	if _, ok := l.reserve("ip:new", now.Add(idleTimeout)); !ok {
+		t.Error("new client was rejected although the other buckets were idle")
+	}
+}
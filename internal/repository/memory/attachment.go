@@ -0,0 +1,54 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/kogamitora/todo/internal/repository"
+)
+
+// AttachmentRepository implements repository.AttachmentRepository.
+type AttachmentRepository struct {
+	s *store
+}
+
+var _ repository.AttachmentRepository = (*AttachmentRepository)(nil)
+
+func (r *AttachmentRepository) Get(ctx context.Context, id int64) (*repository.Attachment, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	a, ok := r.s.attachments[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	found := *a
+	return &found, nil
+}
+
+func (r *AttachmentRepository) List(ctx context.Context, todoID int64) ([]*repository.Attachment, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	var list []*repository.Attachment
+	for _, a := range r.s.attachments {
+		if a.TodoID == todoID {
+			found := *a
+			list = append(list, &found)
+		}
+	}
+	slices.SortFunc(list, func(a, b *repository.Attachment) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return list, nil
+}
+
+func (r *AttachmentRepository) Create(ctx context.Context, a *repository.Attachment) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.s.lastAttachmentID++
+	a.ID = r.s.lastAttachmentID
+	a.CreatedAt = now()
+	stored := *a
+	r.s.attachments[a.ID] = &stored
+	return nil
+}
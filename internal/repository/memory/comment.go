@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/kogamitora/todo/internal/repository"
+)
+
+// comment is a stored comment, kept after it is deleted like a soft
+// deleted row.
+type comment struct {
+	repository.Comment
+	deletedAt time.Time
+}
+
+// CommentRepository implements repository.CommentRepository.
+type CommentRepository struct {
+	s *store
+}
+
+var _ repository.CommentRepository = (*CommentRepository)(nil)
+
+// find returns the comment with id unless it is deleted. mu must be held.
+func (r *CommentRepository) find(id int64) (*comment, error) {
+	c, ok := r.s.comments[id]
+	if !ok || !c.deletedAt.IsZero() {
+		return nil, repository.ErrNotFound
+	}
+	return c, nil
+}
+
+func (r *CommentRepository) Get(ctx context.Context, id int64) (*repository.Comment, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	c, err := r.find(id)
+	if err != nil {
+		return nil, err
+	}
+	found := c.Comment
+	return &found, nil
+}
+
+func (r *CommentRepository) List(ctx context.Context, todoID int64, limit int) ([]*repository.Comment, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	var list []*repository.Comment
+	for _, c := range r.s.comments {
+		if c.TodoID == todoID && c.deletedAt.IsZero() {
+			found := c.Comment
+			list = append(list, &found)
+		}
+	}
+	// IDs increase with the creation time
+	slices.SortFunc(list, func(a, b *repository.Comment) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	if limit > 0 && limit < len(list) {
+		list = list[len(list)-limit:]
+	}
+	return list, nil
+}
+
+func (r *CommentRepository) Create(ctx context.Context, c *repository.Comment) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	created := now()
+	r.s.lastCommentID++
+	c.ID = r.s.lastCommentID
+	c.CreatedAt = created
+	c.UpdatedAt = created
+	r.s.comments[c.ID] = &comment{Comment: *c}
+	return nil
+}
+
+func (r *CommentRepository) Update(ctx context.Context, c *repository.Comment) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	stored, err := r.find(c.ID)
+	if err != nil {
+		return err
+	}
+	c.UpdatedAt = now()
+	stored.Body = c.Body
+	stored.UpdatedAt = c.UpdatedAt
+	return nil
+}
+
+func (r *CommentRepository) Delete(ctx context.Context, id int64) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	stored, err := r.find(id)
+	if err != nil {
+		return err
+	}
+	stored.deletedAt = now()
+	return nil
+}
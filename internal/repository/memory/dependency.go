@@ -0,0 +1,95 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/kogamitora/todo/internal/repository"
+)
+
+// DependencyRepository implements repository.DependencyRepository.
+type DependencyRepository struct {
+	s *store
+}
+
+var _ repository.DependencyRepository = (*DependencyRepository)(nil)
+
+// openBlockers counts the blockers of a todo that are neither deleted nor
+// finished. mu must be held.
+func (s *store) openBlockers(todoID int64) int64 {
+	var count int64
+	for dep := range s.dependencies {
+		if dep.TodoID != todoID {
+			continue
+		}
+		if b, ok := s.todos[dep.BlockedByID]; ok && !b.Deleted() && !finished(b.Status) {
+			count++
+		}
+	}
+	return count
+}
+
+func (r *DependencyRepository) Add(ctx context.Context, dep repository.Dependency) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	if _, ok := r.s.dependencies[dep]; ok {
+		return repository.ErrAlreadyExists
+	}
+	cycle, _ := repository.Reaches(ctx, dep.BlockedByID, dep.TodoID, func(_ context.Context, todoIDs ...int64) ([]repository.Dependency, error) {
+		return r.s.blockers(todoIDs, false), nil
+	})
+	if cycle {
+		return repository.ErrCycle
+	}
+	r.s.dependencies[dep] = struct{}{}
+	return nil
+}
+
+func (r *DependencyRepository) Remove(ctx context.Context, dep repository.Dependency) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	if _, ok := r.s.dependencies[dep]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(r.s.dependencies, dep)
+	return nil
+}
+
+func (r *DependencyRepository) Blockers(ctx context.Context, todoIDs ...int64) ([]repository.Dependency, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	return r.s.blockers(todoIDs, false), nil
+}
+
+func (r *DependencyRepository) ActiveBlockers(ctx context.Context, todoIDs ...int64) ([]repository.Dependency, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	return r.s.blockers(todoIDs, true), nil
+}
+
+// blockers returns the dependencies of todoIDs, without those on deleted
+// todos if active is set. mu must be held.
+func (s *store) blockers(todoIDs []int64, active bool) []repository.Dependency {
+	var list []repository.Dependency
+	for dep := range s.dependencies {
+		if !slices.Contains(todoIDs, dep.TodoID) {
+			continue
+		}
+		b, ok := s.todos[dep.BlockedByID]
+		if !ok || active && b.Deleted() {
+			continue
+		}
+		list = append(list, dep)
+	}
+	slices.SortFunc(list, func(a, b repository.Dependency) int {
+		return cmp.Or(cmp.Compare(a.TodoID, b.TodoID), cmp.Compare(a.BlockedByID, b.BlockedByID))
+	})
+	return list
+}
+
+func (r *DependencyRepository) CountOpenBlockers(ctx context.Context, todoID int64) (int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	return r.s.openBlockers(todoID), nil
+}
@@ -0,0 +1,262 @@
+// Package memory keeps todos in memory, for tests and demos that should
+// run without a database. Everything is lost when the process exits.
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/internal/repository"
+)
+
+// store holds the records of all the repositories of one database. The
+// repositories hand out copies, so callers never share a record.
+type store struct {
+	mu           sync.RWMutex
+	todos        map[int64]*repository.Todo
+	dependencies map[repository.Dependency]struct{}
+	comments     map[int64]*comment
+	attachments  map[int64]*repository.Attachment
+	// the last IDs handed out, one sequence per table like AUTO_INCREMENT
+	lastTodoID, lastCommentID, lastAttachmentID int64
+}
+
+// NewRepositories returns the repositories of a new, empty database.
+func NewRepositories() repository.Repositories {
+	s := &store{
+		todos:        map[int64]*repository.Todo{},
+		dependencies: map[repository.Dependency]struct{}{},
+		comments:     map[int64]*comment{},
+		attachments:  map[int64]*repository.Attachment{},
+	}
+	return repository.Repositories{
+		Todos:        &TodoRepository{s: s},
+		Dependencies: &DependencyRepository{s: s},
+		Comments:     &CommentRepository{s: s},
+		Attachments:  &AttachmentRepository{s: s},
+	}
+}
+
+// TodoRepository implements repository.TodoRepository.
+type TodoRepository struct {
+	s *store
+}
+
+var _ repository.TodoRepository = (*TodoRepository)(nil)
+
+// now returns the current time without its monotonic reading, like a time
+// read back from a database.
+func now() time.Time {
+	return time.Now().Round(0)
+}
+
+// finished reports whether a todo in status no longer blocks others.
+func finished(status todov1.Status) bool {
+	return status == todov1.Status_STATUS_COMPLETED || status == todov1.Status_STATUS_CANCELLED
+}
+
+func copyTodo(t *repository.Todo) *repository.Todo {
+	c := *t
+	return &c
+}
+
+func (r *TodoRepository) Get(ctx context.Context, id int64) (*repository.Todo, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	todo, ok := r.s.todos[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	return copyTodo(todo), nil
+}
+
+func (r *TodoRepository) List(ctx context.Context, opts repository.ListOptions) ([]*repository.Todo, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+
+	var list []*repository.Todo
+	for _, todo := range r.s.todos {
+		if todo.Deleted() {
+			continue
+		}
+		if opts.Status != todov1.Status_STATUS_UNSPECIFIED && todo.Status != opts.Status {
+			continue
+		}
+		switch opts.Dependency {
+		case todov1.DependencyFilter_DEPENDENCY_FILTER_READY:
+			if r.s.openBlockers(todo.ID) > 0 {
+				continue
+			}
+		case todov1.DependencyFilter_DEPENDENCY_FILTER_BLOCKED:
+			if r.s.openBlockers(todo.ID) == 0 {
+				continue
+			}
+		}
+		list = append(list, copyTodo(todo))
+	}
+
+	slices.SortFunc(list, func(a, b *repository.Todo) int {
+		switch opts.Sort {
+		case repository.SortManual:
+			// positions are only comparable within a status column
+			return cmp.Or(cmp.Compare(a.Status, b.Status), strings.Compare(a.Position, b.Position), cmp.Compare(a.ID, b.ID))
+		case repository.SortDueDateAsc:
+			return cmp.Or(compareDueDates(a, b), cmp.Compare(a.ID, b.ID))
+		case repository.SortDueDateDesc:
+			return cmp.Or(-compareDueDates(a, b), cmp.Compare(a.ID, b.ID))
+		}
+		return cmp.Or(b.CreatedAt.Compare(a.CreatedAt), cmp.Compare(b.ID, a.ID))
+	})
+
+	if opts.Offset > 0 {
+		list = list[min(opts.Offset, len(list)):]
+	}
+	if opts.Limit > 0 && opts.Limit < len(list) {
+		list = list[:opts.Limit]
+	}
+	return list, nil
+}
+
+// compareDueDates orders the earliest due date first and todos without one
+// last.
+func compareDueDates(a, b *repository.Todo) int {
+	switch {
+	case a.DueDate.IsZero() && b.DueDate.IsZero():
+		return 0
+	case a.DueDate.IsZero():
+		return 1
+	case b.DueDate.IsZero():
+		return -1
+	}
+	return a.DueDate.Compare(b.DueDate)
+}
+
+func (r *TodoRepository) Create(ctx context.Context, todo *repository.Todo) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	created := now()
+	r.s.lastTodoID++
+	todo.ID = r.s.lastTodoID
+	todo.CreatedAt = created
+	todo.UpdatedAt = created
+	todo.DeletedAt = time.Time{}
+	r.s.todos[todo.ID] = copyTodo(todo)
+	return nil
+}
+
+func (r *TodoRepository) Update(ctx context.Context, todo *repository.Todo) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	stored, ok := r.s.todos[todo.ID]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	todo.UpdatedAt = now()
+	stored.Title = todo.Title
+	stored.Description = todo.Description
+	stored.DueDate = todo.DueDate
+	stored.Status = todo.Status
+	stored.Position = todo.Position
+	stored.UpdatedAt = todo.UpdatedAt
+	return nil
+}
+
+func (r *TodoRepository) Delete(ctx context.Context, id int64) error {
+	return r.setDeletedAt(id, now())
+}
+
+func (r *TodoRepository) Restore(ctx context.Context, id int64) error {
+	return r.setDeletedAt(id, time.Time{})
+}
+
+func (r *TodoRepository) setDeletedAt(id int64, deletedAt time.Time) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	todo, ok := r.s.todos[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	todo.DeletedAt = deletedAt
+	todo.UpdatedAt = now()
+	return nil
+}
+
+func (r *TodoRepository) CountOwned(ctx context.Context, owner string) (int64, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	var count int64
+	for _, todo := range r.s.todos {
+		if todo.CreatedBy == owner && !todo.Deleted() {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *TodoRepository) CountOpen(ctx context.Context, now time.Time) (open, overdue int64, err error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	for _, todo := range r.s.todos {
+		if todo.Deleted() || finished(todo.Status) {
+			continue
+		}
+		open++
+		if !todo.DueDate.IsZero() && todo.DueDate.Before(now) {
+			overdue++
+		}
+	}
+	return open, overdue, nil
+}
+
+func (r *TodoRepository) LastPosition(ctx context.Context, status todov1.Status) (string, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	var last string
+	for _, todo := range r.s.todos {
+		if todo.Status == status && todo.Position > last {
+			last = todo.Position
+		}
+	}
+	return last, nil
+}
+
+func (r *TodoRepository) NeighborPosition(ctx context.Context, status todov1.Status, position string, excludeID int64, before bool) (string, error) {
+	r.s.mu.RLock()
+	defer r.s.mu.RUnlock()
+	var neighbor string
+	found := false
+	for _, todo := range r.s.todos {
+		if todo.Status != status || todo.ID == excludeID {
+			continue
+		}
+		p := todo.Position
+		if before && p < position && (!found || p > neighbor) ||
+			!before && p > position && (!found || p < neighbor) {
+			neighbor, found = p, true
+		}
+	}
+	return neighbor, nil
+}
+
+func (r *TodoRepository) RenumberPositions(ctx context.Context, status todov1.Status, keys func(n int) []string) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	var column []*repository.Todo
+	for _, todo := range r.s.todos {
+		if todo.Status == status {
+			column = append(column, todo)
+		}
+	}
+	slices.SortFunc(column, func(a, b *repository.Todo) int {
+		return cmp.Or(strings.Compare(a.Position, b.Position), cmp.Compare(a.ID, b.ID))
+	})
+	for i, position := range keys(len(column)) {
+		column[i].Position = position
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/internal/repository"
+)
+
+func TestConcurrentCreate(t *testing.T) {
+	ctx := context.Background()
+	repos := NewRepositories()
+
+	const n = 50
+	ids := make(chan int64, n)
+	var wg sync.WaitGroup
+	for range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			todo := &repository.Todo{Title: "a", Status: todov1.Status_STATUS_INCOMPLETE}
+			if err := repos.Todos.Create(ctx, todo); err != nil {
+				t.Error(err)
+				return
+			}
+			if _, err := repos.Todos.List(ctx, repository.ListOptions{}); err != nil {
+				t.Error(err)
+			}
+			ids <- todo.ID
+		}()
+	}
+	wg.Wait()
+	close(ids)
+
+	seen := map[int64]bool{}
+	for id := range ids {
+		if seen[id] {
+			t.Fatalf("ID %d handed out twice", id)
+		}
+		seen[id] = true
+	}
+	list, err := repos.Todos.List(ctx, repository.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != n {
+		t.Errorf("listed %d todos, want %d", len(list), n)
+	}
+}
@@ -0,0 +1,81 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+
+	"github.com/kogamitora/todo/internal/repository"
+	"github.com/kogamitora/todo/models"
+)
+
+// AttachmentRepository implements repository.AttachmentRepository.
+type AttachmentRepository struct {
+	db boil.ContextExecutor
+}
+
+var _ repository.AttachmentRepository = (*AttachmentRepository)(nil)
+
+func NewAttachmentRepository(db boil.ContextExecutor) *AttachmentRepository {
+	return &AttachmentRepository{db: db}
+}
+
+func attachmentFromModel(a *models.Attachment) *repository.Attachment {
+	return &repository.Attachment{
+		ID:          a.ID,
+		TodoID:      a.TodoID,
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Sha256:      a.Sha256,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+func (r *AttachmentRepository) Get(ctx context.Context, id int64) (*repository.Attachment, error) {
+	attachment, err := models.Attachments(
+		models.AttachmentWhere.ID.EQ(id),
+		models.AttachmentWhere.DeletedAt.IsNull(),
+	).One(ctx, r.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return attachmentFromModel(attachment), nil
+}
+
+func (r *AttachmentRepository) List(ctx context.Context, todoID int64) ([]*repository.Attachment, error) {
+	attachments, err := models.Attachments(
+		models.AttachmentWhere.TodoID.EQ(todoID),
+		models.AttachmentWhere.DeletedAt.IsNull(),
+		qm.OrderBy(models.AttachmentColumns.ID+" ASC"),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*repository.Attachment, len(attachments))
+	for i, a := range attachments {
+		list[i] = attachmentFromModel(a)
+	}
+	return list, nil
+}
+
+func (r *AttachmentRepository) Create(ctx context.Context, attachment *repository.Attachment) error {
+	m := &models.Attachment{
+		TodoID:      attachment.TodoID,
+		Filename:    attachment.Filename,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Sha256:      attachment.Sha256,
+	}
+	if err := m.Insert(ctx, r.db, boil.Infer()); err != nil {
+		return err
+	}
+	*attachment = *attachmentFromModel(m)
+	return nil
+}
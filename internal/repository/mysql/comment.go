@@ -0,0 +1,122 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+
+	"github.com/kogamitora/todo/internal/repository"
+	"github.com/kogamitora/todo/models"
+)
+
+// CommentRepository implements repository.CommentRepository.
+type CommentRepository struct {
+	db boil.ContextExecutor
+}
+
+var _ repository.CommentRepository = (*CommentRepository)(nil)
+
+func NewCommentRepository(db boil.ContextExecutor) *CommentRepository {
+	return &CommentRepository{db: db}
+}
+
+func commentFromModel(c *models.Comment) *repository.Comment {
+	return &repository.Comment{
+		ID:        c.ID,
+		TodoID:    c.TodoID,
+		Author:    c.Author,
+		Body:      c.Body,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+func (r *CommentRepository) find(ctx context.Context, id int64) (*models.Comment, error) {
+	comment, err := models.Comments(
+		models.CommentWhere.ID.EQ(id),
+		models.CommentWhere.DeletedAt.IsNull(),
+	).One(ctx, r.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	return comment, err
+}
+
+func (r *CommentRepository) Get(ctx context.Context, id int64) (*repository.Comment, error) {
+	comment, err := r.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return commentFromModel(comment), nil
+}
+
+func (r *CommentRepository) List(ctx context.Context, todoID int64, limit int) ([]*repository.Comment, error) {
+	mods := []qm.QueryMod{
+		models.CommentWhere.TodoID.EQ(todoID),
+		models.CommentWhere.DeletedAt.IsNull(),
+	}
+	if limit > 0 {
+		// newest first so LIMIT keeps the latest ones; reversed below
+		mods = append(mods,
+			qm.OrderBy(models.CommentColumns.CreatedAt+" DESC, "+models.CommentColumns.ID+" DESC"),
+			qm.Limit(limit),
+		)
+	} else {
+		mods = append(mods, qm.OrderBy(models.CommentColumns.CreatedAt+" ASC, "+models.CommentColumns.ID+" ASC"))
+	}
+
+	comments, err := models.Comments(mods...).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 {
+		slices.Reverse(comments)
+	}
+	list := make([]*repository.Comment, len(comments))
+	for i, c := range comments {
+		list[i] = commentFromModel(c)
+	}
+	return list, nil
+}
+
+func (r *CommentRepository) Create(ctx context.Context, comment *repository.Comment) error {
+	m := &models.Comment{
+		TodoID: comment.TodoID,
+		Author: comment.Author,
+		Body:   comment.Body,
+	}
+	if err := m.Insert(ctx, r.db, boil.Infer()); err != nil {
+		return err
+	}
+	*comment = *commentFromModel(m)
+	return nil
+}
+
+func (r *CommentRepository) Update(ctx context.Context, comment *repository.Comment) error {
+	m, err := r.find(ctx, comment.ID)
+	if err != nil {
+		return err
+	}
+	m.Body = comment.Body
+	if _, err := m.Update(ctx, r.db, boil.Infer()); err != nil {
+		return err
+	}
+	*comment = *commentFromModel(m)
+	return nil
+}
+
+func (r *CommentRepository) Delete(ctx context.Context, id int64) error {
+	m, err := r.find(ctx, id)
+	if err != nil {
+		return err
+	}
+	m.DeletedAt = null.TimeFrom(time.Now())
+	_, err = m.Update(ctx, r.db, boil.Whitelist(models.CommentColumns.DeletedAt))
+	return err
+}
@@ -0,0 +1,133 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/go-sql-driver/mysql"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/internal/repository"
+	"github.com/kogamitora/todo/models"
+)
+
+// DependencyRepository implements repository.DependencyRepository.
+type DependencyRepository struct {
+	db DB
+}
+
+var _ repository.DependencyRepository = (*DependencyRepository)(nil)
+
+func NewDependencyRepository(db DB) *DependencyRepository {
+	return &DependencyRepository{db: db}
+}
+
+// errDupEntry is the MySQL error of a duplicate primary or unique key.
+const errDupEntry = 1062
+
+// openBlockerExists matches todos that have at least one blocker which is
+// neither deleted nor finished (completed or cancelled).
+const openBlockerExists = "EXISTS (SELECT 1 FROM `todo_dependencies` d" +
+	" INNER JOIN `todos` b ON b.`id` = d.`blocked_by_id`" +
+	" WHERE d.`todo_id` = `todos`.`id` AND b.`deleted_at` IS NULL AND b.`status` NOT IN (?, ?))"
+
+// dependencyFilterMod returns the query mod for a List dependency filter.
+func dependencyFilterMod(f todov1.DependencyFilter) (qm.QueryMod, bool) {
+	switch f {
+	case todov1.DependencyFilter_DEPENDENCY_FILTER_READY:
+		return qm.Where("NOT "+openBlockerExists, models.TodosStatusTODO_STATUS_COMPLETED, models.TodosStatusTODO_STATUS_CANCELLED), true
+	case todov1.DependencyFilter_DEPENDENCY_FILTER_BLOCKED:
+		return qm.Where(openBlockerExists, models.TodosStatusTODO_STATUS_COMPLETED, models.TodosStatusTODO_STATUS_CANCELLED), true
+	}
+	return nil, false
+}
+
+// Add inserts first and then looks for a cycle with locking reads. A
+// concurrent Add that could close a cycle with this one reads the rows this
+// one inserts, or inserts where this one has read, so one of the two waits
+// for the other to commit, or InnoDB aborts it as a deadlock.
+func (r *DependencyRepository) Add(ctx context.Context, dep repository.Dependency) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	m := &models.TodoDependency{
+		TodoID:      dep.TodoID,
+		BlockedByID: dep.BlockedByID,
+	}
+	if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == errDupEntry {
+			return repository.ErrAlreadyExists
+		}
+		return err
+	}
+	cycle, err := repository.Reaches(ctx, dep.BlockedByID, dep.TodoID, func(ctx context.Context, todoIDs ...int64) ([]repository.Dependency, error) {
+		return blockers(ctx, tx, todoIDs, qm.For("UPDATE"))
+	})
+	if err != nil {
+		return err
+	}
+	if cycle {
+		return repository.ErrCycle
+	}
+	return tx.Commit()
+}
+
+func (r *DependencyRepository) Remove(ctx context.Context, dep repository.Dependency) error {
+	n, err := models.TodoDependencies(
+		models.TodoDependencyWhere.TodoID.EQ(dep.TodoID),
+		models.TodoDependencyWhere.BlockedByID.EQ(dep.BlockedByID),
+	).DeleteAll(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+func (r *DependencyRepository) Blockers(ctx context.Context, todoIDs ...int64) ([]repository.Dependency, error) {
+	return blockers(ctx, r.db, todoIDs)
+}
+
+func (r *DependencyRepository) ActiveBlockers(ctx context.Context, todoIDs ...int64) ([]repository.Dependency, error) {
+	return blockers(ctx, r.db, todoIDs,
+		qm.InnerJoin("`todos` b ON b.`id` = `todo_dependencies`.`blocked_by_id`"),
+		qm.Where("b.`deleted_at` IS NULL"),
+	)
+}
+
+// blockers returns the dependencies of todoIDs selected by mods.
+func blockers(ctx context.Context, db boil.ContextExecutor, todoIDs []int64, mods ...qm.QueryMod) ([]repository.Dependency, error) {
+	if len(todoIDs) == 0 {
+		return nil, nil
+	}
+	mods = append(mods,
+		models.TodoDependencyWhere.TodoID.IN(todoIDs),
+		qm.OrderBy(models.TodoDependencyColumns.TodoID+", "+models.TodoDependencyColumns.BlockedByID),
+	)
+	deps, err := models.TodoDependencies(mods...).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]repository.Dependency, len(deps))
+	for i, d := range deps {
+		list[i] = repository.Dependency{TodoID: d.TodoID, BlockedByID: d.BlockedByID}
+	}
+	return list, nil
+}
+
+func (r *DependencyRepository) CountOpenBlockers(ctx context.Context, todoID int64) (int64, error) {
+	return models.TodoDependencies(
+		qm.InnerJoin("`todos` b ON b.`id` = `todo_dependencies`.`blocked_by_id`"),
+		models.TodoDependencyWhere.TodoID.EQ(todoID),
+		qm.Where("b.`deleted_at` IS NULL"),
+		qm.WhereNotIn("b.`status` NOT IN ?", models.TodosStatusTODO_STATUS_COMPLETED, models.TodosStatusTODO_STATUS_CANCELLED),
+	).Count(ctx, r.db)
+}
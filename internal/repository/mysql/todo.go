@@ -0,0 +1,300 @@
+// Package mysql stores todos in MySQL through the sqlboiler models.
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/internal/repository"
+	"github.com/kogamitora/todo/models"
+)
+
+// TodoRepository implements repository.TodoRepository.
+type TodoRepository struct {
+	db DB
+}
+
+var _ repository.TodoRepository = (*TodoRepository)(nil)
+
+func NewTodoRepository(db DB) *TodoRepository {
+	return &TodoRepository{db: db}
+}
+
+// DB runs queries and starts transactions, as *sql.DB does.
+type DB interface {
+	boil.ContextExecutor
+	boil.ContextBeginner
+}
+
+// NewRepositories returns all the repositories of db.
+func NewRepositories(db DB) repository.Repositories {
+	return repository.Repositories{
+		Todos:        NewTodoRepository(db),
+		Dependencies: NewDependencyRepository(db),
+		Comments:     NewCommentRepository(db),
+		Attachments:  NewAttachmentRepository(db),
+	}
+}
+
+func fromModel(t *models.Todo) *repository.Todo {
+	return &repository.Todo{
+		ID:          t.ID,
+		Title:       t.Title,
+		Description: t.Description.String,
+		DueDate:     t.DueDate.Time,
+		Status:      repository.StatusFromValue(t.Status),
+		Position:    t.Position,
+		CreatedBy:   t.CreatedBy.String,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+		DeletedAt:   t.DeletedAt.Time,
+	}
+}
+
+func toModel(t *repository.Todo) (*models.Todo, error) {
+	status, err := repository.StatusValue(t.Status)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Todo{
+		ID:          t.ID,
+		Title:       t.Title,
+		Description: null.NewString(t.Description, t.Description != ""),
+		DueDate:     null.NewTime(t.DueDate, !t.DueDate.IsZero()),
+		Status:      status,
+		Position:    t.Position,
+		CreatedBy:   null.NewString(t.CreatedBy, t.CreatedBy != ""),
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+		DeletedAt:   null.NewTime(t.DeletedAt, !t.DeletedAt.IsZero()),
+	}, nil
+}
+
+func (r *TodoRepository) Get(ctx context.Context, id int64) (*repository.Todo, error) {
+	todo, err := models.FindTodo(ctx, r.db, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return fromModel(todo), nil
+}
+
+// dueDateOrder sorts todos without a due date after the others; MySQL
+// sorts NULL first in ascending order.
+const dueDateOrder = "CASE WHEN `todos`.`due_date` IS NULL THEN 1 ELSE 0 END"
+
+func (r *TodoRepository) List(ctx context.Context, opts repository.ListOptions) ([]*repository.Todo, error) {
+	mods := []qm.QueryMod{
+		models.TodoWhere.DeletedAt.IsNull(),
+	}
+
+	switch opts.Sort {
+	case repository.SortManual:
+		// positions are only comparable within a status column
+		mods = append(mods, qm.OrderBy(fmt.Sprintf("%s, %s ASC, %s ASC", models.TodoColumns.Status, models.TodoColumns.Position, models.TodoColumns.ID)))
+	case repository.SortDueDateAsc:
+		mods = append(mods, qm.OrderBy(fmt.Sprintf("%s, %s ASC", dueDateOrder, models.TodoColumns.DueDate)))
+	case repository.SortDueDateDesc:
+		mods = append(mods, qm.OrderBy(fmt.Sprintf("%s DESC, %s DESC", dueDateOrder, models.TodoColumns.DueDate)))
+	default:
+		mods = append(mods, qm.OrderBy(models.TodoColumns.CreatedAt+" DESC"))
+	}
+
+	if opts.Status != todov1.Status_STATUS_UNSPECIFIED {
+		status, err := repository.StatusValue(opts.Status)
+		if err != nil {
+			return nil, err
+		}
+		mods = append(mods, models.TodoWhere.Status.EQ(status))
+	}
+	if mod, ok := dependencyFilterMod(opts.Dependency); ok {
+		mods = append(mods, mod)
+	}
+	if opts.Limit > 0 {
+		mods = append(mods, qm.Limit(opts.Limit))
+	}
+	if opts.Offset > 0 {
+		if opts.Limit == 0 {
+			// MySQL has no OFFSET without LIMIT
+			mods = append(mods, qm.Limit(1<<62))
+		}
+		mods = append(mods, qm.Offset(opts.Offset))
+	}
+
+	todos, err := models.Todos(mods...).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*repository.Todo, len(todos))
+	for i, t := range todos {
+		list[i] = fromModel(t)
+	}
+	return list, nil
+}
+
+func (r *TodoRepository) Create(ctx context.Context, todo *repository.Todo) error {
+	m, err := toModel(todo)
+	if err != nil {
+		return err
+	}
+	if err := m.Insert(ctx, r.db, boil.Infer()); err != nil {
+		return err
+	}
+	*todo = *fromModel(m)
+	return nil
+}
+
+func (r *TodoRepository) Update(ctx context.Context, todo *repository.Todo) error {
+	m, err := toModel(todo)
+	if err != nil {
+		return err
+	}
+	// the number of updated rows is not checked: MySQL reports rows whose
+	// values did not change as unaffected
+	_, err = m.Update(ctx, r.db, boil.Whitelist(
+		models.TodoColumns.Title,
+		models.TodoColumns.Description,
+		models.TodoColumns.DueDate,
+		models.TodoColumns.Status,
+		models.TodoColumns.Position,
+		models.TodoColumns.UpdatedAt,
+	))
+	if err != nil {
+		return err
+	}
+	todo.UpdatedAt = m.UpdatedAt
+	return nil
+}
+
+func (r *TodoRepository) Delete(ctx context.Context, id int64) error {
+	return r.setDeletedAt(ctx, id, null.TimeFrom(time.Now()))
+}
+
+func (r *TodoRepository) Restore(ctx context.Context, id int64) error {
+	return r.setDeletedAt(ctx, id, null.Time{})
+}
+
+func (r *TodoRepository) setDeletedAt(ctx context.Context, id int64, deletedAt null.Time) error {
+	exists, err := models.TodoExists(ctx, r.db, id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return repository.ErrNotFound
+	}
+	_, err = models.Todos(models.TodoWhere.ID.EQ(id)).UpdateAll(ctx, r.db, models.M{
+		models.TodoColumns.DeletedAt: deletedAt,
+	})
+	return err
+}
+
+func (r *TodoRepository) CountOwned(ctx context.Context, owner string) (int64, error) {
+	where := models.TodoWhere.CreatedBy.IsNull()
+	if owner != "" {
+		where = models.TodoWhere.CreatedBy.EQ(null.StringFrom(owner))
+	}
+	return models.Todos(where, models.TodoWhere.DeletedAt.IsNull()).Count(ctx, r.db)
+}
+
+func (r *TodoRepository) CountOpen(ctx context.Context, now time.Time) (open, overdue int64, err error) {
+	mods := []qm.QueryMod{
+		models.TodoWhere.DeletedAt.IsNull(),
+		qm.WhereNotIn("`todos`.`status` NOT IN ?", models.TodosStatusTODO_STATUS_COMPLETED, models.TodosStatusTODO_STATUS_CANCELLED),
+	}
+	if open, err = models.Todos(mods...).Count(ctx, r.db); err != nil {
+		return 0, 0, err
+	}
+	overdue, err = models.Todos(append(mods, models.TodoWhere.DueDate.LT(null.TimeFrom(now)))...).Count(ctx, r.db)
+	if err != nil {
+		return 0, 0, err
+	}
+	return open, overdue, nil
+}
+
+func (r *TodoRepository) LastPosition(ctx context.Context, status todov1.Status) (string, error) {
+	s, err := repository.StatusValue(status)
+	if err != nil {
+		return "", err
+	}
+	last, err := models.Todos(
+		qm.Select(models.TodoColumns.Position),
+		models.TodoWhere.Status.EQ(s),
+		qm.OrderBy(models.TodoColumns.Position+" DESC"),
+	).One(ctx, r.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return last.Position, nil
+}
+
+func (r *TodoRepository) NeighborPosition(ctx context.Context, status todov1.Status, position string, excludeID int64, before bool) (string, error) {
+	s, err := repository.StatusValue(status)
+	if err != nil {
+		return "", err
+	}
+	mods := []qm.QueryMod{
+		qm.Select(models.TodoColumns.Position),
+		models.TodoWhere.Status.EQ(s),
+		models.TodoWhere.ID.NEQ(excludeID),
+	}
+	if before {
+		mods = append(mods, models.TodoWhere.Position.LT(position), qm.OrderBy(models.TodoColumns.Position+" DESC"))
+	} else {
+		mods = append(mods, models.TodoWhere.Position.GT(position), qm.OrderBy(models.TodoColumns.Position+" ASC"))
+	}
+
+	neighbor, err := models.Todos(mods...).One(ctx, r.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return neighbor.Position, nil
+}
+
+func (r *TodoRepository) RenumberPositions(ctx context.Context, status todov1.Status, keys func(n int) []string) error {
+	s, err := repository.StatusValue(status)
+	if err != nil {
+		return err
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	todos, err := models.Todos(
+		qm.Select(models.TodoColumns.ID),
+		models.TodoWhere.Status.EQ(s),
+		qm.OrderBy(models.TodoColumns.Position+", "+models.TodoColumns.ID),
+		qm.For("UPDATE"),
+	).All(ctx, tx)
+	if err != nil {
+		return err
+	}
+	for i, position := range keys(len(todos)) {
+		// assigning updated_at to itself keeps ON UPDATE CURRENT_TIMESTAMP
+		// from touching it
+		if _, err := tx.ExecContext(ctx,
+			"UPDATE `todos` SET `position` = ?, `updated_at` = `updated_at` WHERE `id` = ?",
+			position, todos[i].ID,
+		); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+
+	"github.com/kogamitora/todo/internal/repository"
+	"github.com/kogamitora/todo/pgmodels"
+)
+
+// AttachmentRepository implements repository.AttachmentRepository.
+type AttachmentRepository struct {
+	db boil.ContextExecutor
+}
+
+var _ repository.AttachmentRepository = (*AttachmentRepository)(nil)
+
+func NewAttachmentRepository(db boil.ContextExecutor) *AttachmentRepository {
+	return &AttachmentRepository{db: db}
+}
+
+func attachmentFromModel(a *pgmodels.Attachment) *repository.Attachment {
+	return &repository.Attachment{
+		ID:          a.ID,
+		TodoID:      a.TodoID,
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Sha256:      a.Sha256,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+func (r *AttachmentRepository) Get(ctx context.Context, id int64) (*repository.Attachment, error) {
+	attachment, err := pgmodels.Attachments(
+		pgmodels.AttachmentWhere.ID.EQ(id),
+		pgmodels.AttachmentWhere.DeletedAt.IsNull(),
+	).One(ctx, r.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return attachmentFromModel(attachment), nil
+}
+
+func (r *AttachmentRepository) List(ctx context.Context, todoID int64) ([]*repository.Attachment, error) {
+	attachments, err := pgmodels.Attachments(
+		pgmodels.AttachmentWhere.TodoID.EQ(todoID),
+		pgmodels.AttachmentWhere.DeletedAt.IsNull(),
+		qm.OrderBy(pgmodels.AttachmentColumns.ID+" ASC"),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*repository.Attachment, len(attachments))
+	for i, a := range attachments {
+		list[i] = attachmentFromModel(a)
+	}
+	return list, nil
+}
+
+func (r *AttachmentRepository) Create(ctx context.Context, attachment *repository.Attachment) error {
+	m := &pgmodels.Attachment{
+		TodoID:      attachment.TodoID,
+		Filename:    attachment.Filename,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Sha256:      attachment.Sha256,
+	}
+	if err := m.Insert(ctx, r.db, boil.Infer()); err != nil {
+		return err
+	}
+	*attachment = *attachmentFromModel(m)
+	return nil
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/internal/repository"
+	"github.com/kogamitora/todo/pgmodels"
+)
+
+// DependencyRepository implements repository.DependencyRepository.
+type DependencyRepository struct {
+	db DB
+}
+
+var _ repository.DependencyRepository = (*DependencyRepository)(nil)
+
+func NewDependencyRepository(db DB) *DependencyRepository {
+	return &DependencyRepository{db: db}
+}
+
+// openBlockerExists matches todos that have at least one blocker which is
+// neither deleted nor finished (completed or cancelled).
+const openBlockerExists = "EXISTS (SELECT 1 FROM todo_dependencies d" +
+	" INNER JOIN todos b ON b.id = d.blocked_by_id" +
+	" WHERE d.todo_id = todos.id AND b.deleted_at IS NULL AND b.status NOT IN (?, ?))"
+
+// dependencyFilterMod returns the query mod for a List dependency filter.
+func dependencyFilterMod(f todov1.DependencyFilter) (qm.QueryMod, bool) {
+	switch f {
+	case todov1.DependencyFilter_DEPENDENCY_FILTER_READY:
+		return qm.Where("NOT "+openBlockerExists, pgmodels.TodoStatusTODO_STATUS_COMPLETED, pgmodels.TodoStatusTODO_STATUS_CANCELLED), true
+	case todov1.DependencyFilter_DEPENDENCY_FILTER_BLOCKED:
+		return qm.Where(openBlockerExists, pgmodels.TodoStatusTODO_STATUS_COMPLETED, pgmodels.TodoStatusTODO_STATUS_CANCELLED), true
+	}
+	return nil, false
+}
+
+// dependencyLock is the advisory lock that serializes Add.
+const dependencyLock = 0x746f646f_64657073 // "tododeps"
+
+// Add takes a transaction-level advisory lock before it inserts and looks
+// for a cycle. Row locks would not do: PostgreSQL has no gap locks, so a
+// concurrent Add could insert an edge this one has already walked past.
+func (r *DependencyRepository) Add(ctx context.Context, dep repository.Dependency) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", int64(dependencyLock)); err != nil {
+		return err
+	}
+	res, err := tx.ExecContext(ctx,
+		"INSERT INTO todo_dependencies (todo_id, blocked_by_id) VALUES ($1, $2) ON CONFLICT DO NOTHING",
+		dep.TodoID, dep.BlockedByID,
+	)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repository.ErrAlreadyExists
+	}
+	cycle, err := repository.Reaches(ctx, dep.BlockedByID, dep.TodoID, func(ctx context.Context, todoIDs ...int64) ([]repository.Dependency, error) {
+		return blockers(ctx, tx, todoIDs)
+	})
+	if err != nil {
+		return err
+	}
+	if cycle {
+		return repository.ErrCycle
+	}
+	return tx.Commit()
+}
+
+func (r *DependencyRepository) Remove(ctx context.Context, dep repository.Dependency) error {
+	n, err := pgmodels.TodoDependencies(
+		pgmodels.TodoDependencyWhere.TodoID.EQ(dep.TodoID),
+		pgmodels.TodoDependencyWhere.BlockedByID.EQ(dep.BlockedByID),
+	).DeleteAll(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+func (r *DependencyRepository) Blockers(ctx context.Context, todoIDs ...int64) ([]repository.Dependency, error) {
+	return blockers(ctx, r.db, todoIDs)
+}
+
+func (r *DependencyRepository) ActiveBlockers(ctx context.Context, todoIDs ...int64) ([]repository.Dependency, error) {
+	return blockers(ctx, r.db, todoIDs,
+		qm.InnerJoin("todos b ON b.id = todo_dependencies.blocked_by_id"),
+		qm.Where("b.deleted_at IS NULL"),
+	)
+}
+
+// blockers returns the dependencies of todoIDs selected by mods.
+func blockers(ctx context.Context, db boil.ContextExecutor, todoIDs []int64, mods ...qm.QueryMod) ([]repository.Dependency, error) {
+	if len(todoIDs) == 0 {
+		return nil, nil
+	}
+	mods = append(mods,
+		pgmodels.TodoDependencyWhere.TodoID.IN(todoIDs),
+		qm.OrderBy(pgmodels.TodoDependencyColumns.TodoID+", "+pgmodels.TodoDependencyColumns.BlockedByID),
+	)
+	deps, err := pgmodels.TodoDependencies(mods...).All(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]repository.Dependency, len(deps))
+	for i, d := range deps {
+		list[i] = repository.Dependency{TodoID: d.TodoID, BlockedByID: d.BlockedByID}
+	}
+	return list, nil
+}
+
+func (r *DependencyRepository) CountOpenBlockers(ctx context.Context, todoID int64) (int64, error) {
+	return pgmodels.TodoDependencies(
+		qm.InnerJoin("todos b ON b.id = todo_dependencies.blocked_by_id"),
+		pgmodels.TodoDependencyWhere.TodoID.EQ(todoID),
+		qm.Where("b.deleted_at IS NULL"),
+		qm.WhereNotIn("b.status NOT IN ?", pgmodels.TodoStatusTODO_STATUS_COMPLETED, pgmodels.TodoStatusTODO_STATUS_CANCELLED),
+	).Count(ctx, r.db)
+}
@@ -0,0 +1,288 @@
+// Package postgres stores todos in PostgreSQL through the sqlboiler models
+// generated into pgmodels.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/internal/repository"
+	"github.com/kogamitora/todo/pgmodels"
+)
+
+// TodoRepository implements repository.TodoRepository.
+type TodoRepository struct {
+	db DB
+}
+
+var _ repository.TodoRepository = (*TodoRepository)(nil)
+
+func NewTodoRepository(db DB) *TodoRepository {
+	return &TodoRepository{db: db}
+}
+
+// DB runs queries and starts transactions, as *sql.DB does.
+type DB interface {
+	boil.ContextExecutor
+	boil.ContextBeginner
+}
+
+// NewRepositories returns all the repositories of db.
+func NewRepositories(db DB) repository.Repositories {
+	return repository.Repositories{
+		Todos:        NewTodoRepository(db),
+		Dependencies: NewDependencyRepository(db),
+		Comments:     NewCommentRepository(db),
+		Attachments:  NewAttachmentRepository(db),
+	}
+}
+
+func fromModel(t *pgmodels.Todo) *repository.Todo {
+	return &repository.Todo{
+		ID:          t.ID,
+		Title:       t.Title,
+		Description: t.Description.String,
+		DueDate:     t.DueDate.Time,
+		Status:      repository.StatusFromValue(t.Status),
+		Position:    t.Position,
+		CreatedBy:   t.CreatedBy.String,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+		DeletedAt:   t.DeletedAt.Time,
+	}
+}
+
+func toModel(t *repository.Todo) (*pgmodels.Todo, error) {
+	status, err := repository.StatusValue(t.Status)
+	if err != nil {
+		return nil, err
+	}
+	return &pgmodels.Todo{
+		ID:          t.ID,
+		Title:       t.Title,
+		Description: null.NewString(t.Description, t.Description != ""),
+		DueDate:     null.NewTime(t.DueDate, !t.DueDate.IsZero()),
+		Status:      status,
+		Position:    t.Position,
+		CreatedBy:   null.NewString(t.CreatedBy, t.CreatedBy != ""),
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+		DeletedAt:   null.NewTime(t.DeletedAt, !t.DeletedAt.IsZero()),
+	}, nil
+}
+
+func (r *TodoRepository) Get(ctx context.Context, id int64) (*repository.Todo, error) {
+	todo, err := pgmodels.FindTodo(ctx, r.db, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return fromModel(todo), nil
+}
+
+func (r *TodoRepository) List(ctx context.Context, opts repository.ListOptions) ([]*repository.Todo, error) {
+	mods := []qm.QueryMod{
+		pgmodels.TodoWhere.DeletedAt.IsNull(),
+	}
+
+	switch opts.Sort {
+	case repository.SortManual:
+		// positions are only comparable within a status column
+		mods = append(mods, qm.OrderBy(fmt.Sprintf("%s, %s ASC, %s ASC", pgmodels.TodoColumns.Status, pgmodels.TodoColumns.Position, pgmodels.TodoColumns.ID)))
+	case repository.SortDueDateAsc:
+		mods = append(mods, qm.OrderBy(pgmodels.TodoColumns.DueDate+" ASC NULLS LAST"))
+	case repository.SortDueDateDesc:
+		mods = append(mods, qm.OrderBy(pgmodels.TodoColumns.DueDate+" DESC NULLS FIRST"))
+	default:
+		mods = append(mods, qm.OrderBy(pgmodels.TodoColumns.CreatedAt+" DESC"))
+	}
+
+	if opts.Status != todov1.Status_STATUS_UNSPECIFIED {
+		status, err := repository.StatusValue(opts.Status)
+		if err != nil {
+			return nil, err
+		}
+		mods = append(mods, pgmodels.TodoWhere.Status.EQ(status))
+	}
+	if mod, ok := dependencyFilterMod(opts.Dependency); ok {
+		mods = append(mods, mod)
+	}
+	if opts.Limit > 0 {
+		mods = append(mods, qm.Limit(opts.Limit))
+	}
+	if opts.Offset > 0 {
+		mods = append(mods, qm.Offset(opts.Offset))
+	}
+
+	todos, err := pgmodels.Todos(mods...).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*repository.Todo, len(todos))
+	for i, t := range todos {
+		list[i] = fromModel(t)
+	}
+	return list, nil
+}
+
+func (r *TodoRepository) Create(ctx context.Context, todo *repository.Todo) error {
+	m, err := toModel(todo)
+	if err != nil {
+		return err
+	}
+	if err := m.Insert(ctx, r.db, boil.Infer()); err != nil {
+		return err
+	}
+	*todo = *fromModel(m)
+	return nil
+}
+
+func (r *TodoRepository) Update(ctx context.Context, todo *repository.Todo) error {
+	m, err := toModel(todo)
+	if err != nil {
+		return err
+	}
+	_, err = m.Update(ctx, r.db, boil.Whitelist(
+		pgmodels.TodoColumns.Title,
+		pgmodels.TodoColumns.Description,
+		pgmodels.TodoColumns.DueDate,
+		pgmodels.TodoColumns.Status,
+		pgmodels.TodoColumns.Position,
+		pgmodels.TodoColumns.UpdatedAt,
+	))
+	if err != nil {
+		return err
+	}
+	todo.UpdatedAt = m.UpdatedAt
+	return nil
+}
+
+func (r *TodoRepository) Delete(ctx context.Context, id int64) error {
+	return r.setDeletedAt(ctx, id, null.TimeFrom(time.Now()))
+}
+
+func (r *TodoRepository) Restore(ctx context.Context, id int64) error {
+	return r.setDeletedAt(ctx, id, null.Time{})
+}
+
+func (r *TodoRepository) setDeletedAt(ctx context.Context, id int64, deletedAt null.Time) error {
+	// PostgreSQL counts the rows matched, changed or not. There is no ON
+	// UPDATE, so updated_at is set here.
+	n, err := pgmodels.Todos(pgmodels.TodoWhere.ID.EQ(id)).UpdateAll(ctx, r.db, pgmodels.M{
+		pgmodels.TodoColumns.DeletedAt: deletedAt,
+		pgmodels.TodoColumns.UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+func (r *TodoRepository) CountOwned(ctx context.Context, owner string) (int64, error) {
+	where := pgmodels.TodoWhere.CreatedBy.IsNull()
+	if owner != "" {
+		where = pgmodels.TodoWhere.CreatedBy.EQ(null.StringFrom(owner))
+	}
+	return pgmodels.Todos(where, pgmodels.TodoWhere.DeletedAt.IsNull()).Count(ctx, r.db)
+}
+
+func (r *TodoRepository) CountOpen(ctx context.Context, now time.Time) (open, overdue int64, err error) {
+	mods := []qm.QueryMod{
+		pgmodels.TodoWhere.DeletedAt.IsNull(),
+		qm.WhereNotIn("todos.status NOT IN ?", pgmodels.TodoStatusTODO_STATUS_COMPLETED, pgmodels.TodoStatusTODO_STATUS_CANCELLED),
+	}
+	if open, err = pgmodels.Todos(mods...).Count(ctx, r.db); err != nil {
+		return 0, 0, err
+	}
+	overdue, err = pgmodels.Todos(append(mods, pgmodels.TodoWhere.DueDate.LT(null.TimeFrom(now)))...).Count(ctx, r.db)
+	if err != nil {
+		return 0, 0, err
+	}
+	return open, overdue, nil
+}
+
+func (r *TodoRepository) LastPosition(ctx context.Context, status todov1.Status) (string, error) {
+	s, err := repository.StatusValue(status)
+	if err != nil {
+		return "", err
+	}
+	last, err := pgmodels.Todos(
+		qm.Select(pgmodels.TodoColumns.Position),
+		pgmodels.TodoWhere.Status.EQ(s),
+		qm.OrderBy(pgmodels.TodoColumns.Position+" DESC"),
+	).One(ctx, r.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return last.Position, nil
+}
+
+func (r *TodoRepository) NeighborPosition(ctx context.Context, status todov1.Status, position string, excludeID int64, before bool) (string, error) {
+	s, err := repository.StatusValue(status)
+	if err != nil {
+		return "", err
+	}
+	mods := []qm.QueryMod{
+		qm.Select(pgmodels.TodoColumns.Position),
+		pgmodels.TodoWhere.Status.EQ(s),
+		pgmodels.TodoWhere.ID.NEQ(excludeID),
+	}
+	if before {
+		mods = append(mods, pgmodels.TodoWhere.Position.LT(position), qm.OrderBy(pgmodels.TodoColumns.Position+" DESC"))
+	} else {
+		mods = append(mods, pgmodels.TodoWhere.Position.GT(position), qm.OrderBy(pgmodels.TodoColumns.Position+" ASC"))
+	}
+
+	neighbor, err := pgmodels.Todos(mods...).One(ctx, r.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return neighbor.Position, nil
+}
+
+func (r *TodoRepository) RenumberPositions(ctx context.Context, status todov1.Status, keys func(n int) []string) error {
+	s, err := repository.StatusValue(status)
+	if err != nil {
+		return err
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	todos, err := pgmodels.Todos(
+		qm.Select(pgmodels.TodoColumns.ID),
+		pgmodels.TodoWhere.Status.EQ(s),
+		qm.OrderBy(pgmodels.TodoColumns.Position+", "+pgmodels.TodoColumns.ID),
+		qm.For("UPDATE"),
+	).All(ctx, tx)
+	if err != nil {
+		return err
+	}
+	for i, position := range keys(len(todos)) {
+		if _, err := tx.ExecContext(ctx, "UPDATE todos SET position = $1 WHERE id = $2", position, todos[i].ID); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,216 @@
+// Package repository defines how the todo service stores todos, so that
+// its business rules do not depend on a particular database.
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+)
+
+var (
+	// ErrNotFound is returned when the requested record does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrAlreadyExists is returned when creating a record that exists.
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrCycle is returned when a dependency would make a todo wait on
+	// itself, directly or through other todos.
+	ErrCycle = errors.New("dependency would create a cycle")
+)
+
+// Todo is a stored todo.
+type Todo struct {
+	ID          int64
+	Title       string
+	Description string
+	DueDate     time.Time // zero if there is none
+	Status      todov1.Status
+	// Position orders the todos within their status column. Positions are
+	// compared as binary strings.
+	Position string
+	// CreatedBy is the user that created the todo, empty if unknown.
+	CreatedBy string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt time.Time // zero unless soft deleted
+}
+
+// Deleted reports whether the todo has been soft deleted.
+func (t *Todo) Deleted() bool {
+	return !t.DeletedAt.IsZero()
+}
+
+// Sort is the order of List results.
+type Sort int
+
+const (
+	// SortCreatedDesc lists the newest todos first.
+	SortCreatedDesc Sort = iota
+	// SortManual lists todos by status column, then by position.
+	SortManual
+	// SortDueDateAsc lists the earliest due date first and todos without
+	// one last.
+	SortDueDateAsc
+	// SortDueDateDesc lists todos without a due date first, then the latest
+	// due date first.
+	SortDueDateDesc
+)
+
+// ListOptions selects and orders the todos returned by List. Deleted todos
+// are never listed.
+type ListOptions struct {
+	// Status keeps the todos in one status; STATUS_UNSPECIFIED keeps all.
+	Status todov1.Status
+	// Dependency keeps ready or blocked todos, where a todo is blocked while
+	// one of its blockers is neither deleted, completed nor cancelled.
+	Dependency todov1.DependencyFilter
+	Sort       Sort
+	// Limit caps the number of todos returned, zero means no cap. Offset
+	// skips that many todos first.
+	Limit  int
+	Offset int
+}
+
+// TodoRepository stores todos.
+type TodoRepository interface {
+	// Get returns the todo with id, including a deleted one, or ErrNotFound.
+	Get(ctx context.Context, id int64) (*Todo, error)
+	// List returns the todos selected by opts.
+	List(ctx context.Context, opts ListOptions) ([]*Todo, error)
+	// Create stores a new todo and sets its ID and timestamps.
+	Create(ctx context.Context, todo *Todo) error
+	// Update stores the title, description, due date, status and position of
+	// todo and sets its UpdatedAt.
+	Update(ctx context.Context, todo *Todo) error
+	// Delete soft deletes the todo with id. Restore undoes it. Both set
+	// its UpdatedAt and return ErrNotFound if there is no such todo.
+	Delete(ctx context.Context, id int64) error
+	Restore(ctx context.Context, id int64) error
+
+	// CountOwned counts the todos created by owner that are not deleted.
+	// An empty owner counts the todos created without a user.
+	CountOwned(ctx context.Context, owner string) (int64, error)
+	// CountOpen counts the todos that are neither deleted, completed nor
+	// cancelled, and how many of them were due before now.
+	CountOpen(ctx context.Context, now time.Time) (open, overdue int64, err error)
+	// LastPosition returns the greatest position in a status column, or ""
+	// if the column is empty.
+	LastPosition(ctx context.Context, status todov1.Status) (string, error)
+	// NeighborPosition returns the position adjacent to position in a status
+	// column, the previous one with before set and the next one otherwise,
+	// ignoring the todo excludeID. It returns "" at the edge of the column.
+	NeighborPosition(ctx context.Context, status todov1.Status, position string, excludeID int64, before bool) (string, error)
+	// RenumberPositions gives the n todos of a status column, deleted ones
+	// included, the positions keys(n) in their current order by position
+	// and ID. It leaves UpdatedAt alone and changes every position or none.
+	RenumberPositions(ctx context.Context, status todov1.Status, keys func(n int) []string) error
+}
+
+// Dependency records that TodoID cannot be completed before BlockedByID.
+type Dependency struct {
+	TodoID      int64
+	BlockedByID int64
+}
+
+// DependencyRepository stores the dependencies between todos.
+type DependencyRepository interface {
+	// Add stores a dependency, or returns ErrAlreadyExists. It returns
+	// ErrCycle if dep.TodoID is reachable from dep.BlockedByID. The check
+	// and the insert are atomic, so that concurrent calls cannot close a
+	// cycle between them.
+	Add(ctx context.Context, dep Dependency) error
+	// Remove deletes a dependency, or returns ErrNotFound.
+	Remove(ctx context.Context, dep Dependency) error
+	// Blockers returns the dependencies of the given todos, including those
+	// on deleted todos, ordered by TodoID and BlockedByID.
+	Blockers(ctx context.Context, todoIDs ...int64) ([]Dependency, error)
+	// ActiveBlockers is Blockers without the dependencies on deleted todos.
+	ActiveBlockers(ctx context.Context, todoIDs ...int64) ([]Dependency, error)
+	// CountOpenBlockers counts the blockers of a todo that are neither
+	// deleted, completed nor cancelled.
+	CountOpenBlockers(ctx context.Context, todoID int64) (int64, error)
+}
+
+// Reaches reports whether to is reachable from from by following the
+// blocked_by edges that blockers loads, as a DependencyRepository's
+// Blockers does. A todo reaches itself.
+func Reaches(ctx context.Context, from, to int64, blockers func(ctx context.Context, todoIDs ...int64) ([]Dependency, error)) (bool, error) {
+	visited := map[int64]bool{from: true}
+	frontier := []int64{from}
+	for len(frontier) > 0 {
+		if visited[to] {
+			return true, nil
+		}
+		deps, err := blockers(ctx, frontier...)
+		if err != nil {
+			return false, err
+		}
+		frontier = frontier[:0]
+		for _, d := range deps {
+			if !visited[d.BlockedByID] {
+				visited[d.BlockedByID] = true
+				frontier = append(frontier, d.BlockedByID)
+			}
+		}
+	}
+	return visited[to], nil
+}
+
+// Comment is a stored comment on a todo.
+type Comment struct {
+	ID        int64
+	TodoID    int64
+	Author    string
+	Body      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CommentRepository stores the comments on todos. Deleted comments are
+// never returned.
+type CommentRepository interface {
+	// Get returns the comment with id, or ErrNotFound.
+	Get(ctx context.Context, id int64) (*Comment, error)
+	// List returns the comments on a todo, oldest first. A positive limit
+	// keeps only the latest limit comments.
+	List(ctx context.Context, todoID int64, limit int) ([]*Comment, error)
+	// Create stores a new comment and sets its ID and timestamps.
+	Create(ctx context.Context, comment *Comment) error
+	// Update stores the body of comment and sets its UpdatedAt.
+	Update(ctx context.Context, comment *Comment) error
+	// Delete soft deletes the comment with id, or returns ErrNotFound.
+	Delete(ctx context.Context, id int64) error
+}
+
+// Attachment is the stored metadata of a file attached to a todo. The
+// contents are kept in a blob store under their SHA-256.
+type Attachment struct {
+	ID          int64
+	TodoID      int64
+	Filename    string
+	ContentType string
+	Size        int64
+	Sha256      string
+	CreatedAt   time.Time
+}
+
+// AttachmentRepository stores the attachment metadata. Deleted attachments
+// are never returned.
+type AttachmentRepository interface {
+	// Get returns the attachment with id, or ErrNotFound.
+	Get(ctx context.Context, id int64) (*Attachment, error)
+	// List returns the attachments of a todo in the order they were added.
+	List(ctx context.Context, todoID int64) ([]*Attachment, error)
+	// Create stores a new attachment and sets its ID and CreatedAt.
+	Create(ctx context.Context, attachment *Attachment) error
+}
+
+// Repositories are the stores of one database.
+type Repositories struct {
+	Todos        TodoRepository
+	Dependencies DependencyRepository
+	Comments     CommentRepository
+	Attachments  AttachmentRepository
+}
@@ -0,0 +1,124 @@
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	"github.com/kogamitora/todo/internal/apierr"
+	"github.com/kogamitora/todo/internal/config"
+	"github.com/kogamitora/todo/internal/repository"
+)
+
+// RunErrors checks that the errors of the database behind the repositories
+// reach the client with the code and reason apierr.Convert promises, so
+// that every backend fails the same way.
+func RunErrors(t *testing.T, driver string, open func(t *testing.T) (*sql.DB, repository.Repositories)) {
+	for _, tt := range []struct {
+		name string
+		test func(t *testing.T, driver string, database *sql.DB, r repository.Repositories)
+	}{
+		{"DuplicateKey", testDuplicateKey},
+		{"ForeignKey", testForeignKey},
+		{"LockTimeout", testLockTimeout},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			database, r := open(t)
+			tt.test(t, driver, database, r)
+		})
+	}
+}
+
+// wantCode fails unless err converts to code with the ErrorInfo reason.
+func wantCode(t *testing.T, err error, code connect.Code, reason string) {
+	t.Helper()
+	if err == nil {
+		t.Fatalf("error = nil, want %v", code)
+	}
+	converted := apierr.Convert(err)
+	if converted.Code() != code {
+		t.Fatalf("code = %v, want %v (%v)", converted.Code(), code, err)
+	}
+	for _, d := range converted.Details() {
+		v, derr := d.Value()
+		if info, ok := v.(*errdetails.ErrorInfo); derr == nil && ok {
+			if info.Reason != reason {
+				t.Errorf("reason = %s, want %s (%v)", info.Reason, reason, err)
+			}
+			return
+		}
+	}
+	t.Errorf("no ErrorInfo in %v", converted)
+}
+
+func testDuplicateKey(t *testing.T, _ string, database *sql.DB, r repository.Repositories) {
+	ctx := context.Background()
+	a := create(t, r, repository.Todo{Title: "a"})
+	b := create(t, r, repository.Todo{Title: "b"})
+
+	// the repositories turn a duplicate dependency into ErrAlreadyExists,
+	// so insert it directly
+	insert := fmt.Sprintf("INSERT INTO todo_dependencies (todo_id, blocked_by_id) VALUES (%d, %d)", a.ID, b.ID)
+	if _, err := database.ExecContext(ctx, insert); err != nil {
+		t.Fatal(err)
+	}
+	_, err := database.ExecContext(ctx, insert)
+	wantCode(t, err, connect.CodeAlreadyExists, apierr.ReasonDuplicateKey)
+}
+
+func testForeignKey(t *testing.T, _ string, _ *sql.DB, r repository.Repositories) {
+	ctx := context.Background()
+	a := create(t, r, repository.Todo{Title: "a"})
+
+	err := r.Comments.Create(ctx, &repository.Comment{TodoID: a.ID + 1000, Author: "alice", Body: "orphan"})
+	wantCode(t, err, connect.CodeFailedPrecondition, apierr.ReasonForeignKeyViolation)
+	err = r.Attachments.Create(ctx, &repository.Attachment{TodoID: a.ID + 1000, Filename: "a.txt", ContentType: "text/plain", Sha256: "00"})
+	wantCode(t, err, connect.CodeFailedPrecondition, apierr.ReasonForeignKeyViolation)
+}
+
+func testLockTimeout(t *testing.T, driver string, database *sql.DB, r repository.Repositories) {
+	ctx := context.Background()
+	a := create(t, r, repository.Todo{Title: "a"})
+
+	// lock the todo, or the whole database for SQLite, on one connection
+	holder, err := database.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer holder.Close()
+	waiter, err := database.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer waiter.Close()
+
+	var lock []string
+	var timeout string
+	switch driver {
+	case config.DriverSQLite:
+		lock = []string{"BEGIN IMMEDIATE"}
+		timeout = "PRAGMA busy_timeout = 0"
+	case config.DriverPostgres:
+		lock = []string{"BEGIN", fmt.Sprintf("SELECT id FROM todos WHERE id = %d FOR UPDATE", a.ID)}
+		timeout = "SET lock_timeout = '100ms'"
+	default:
+		lock = []string{"BEGIN", fmt.Sprintf("SELECT id FROM todos WHERE id = %d FOR UPDATE", a.ID)}
+		timeout = "SET SESSION innodb_lock_wait_timeout = 1"
+	}
+	for _, q := range lock {
+		if _, err := holder.ExecContext(ctx, q); err != nil {
+			t.Fatal(err)
+		}
+	}
+	defer holder.ExecContext(ctx, "ROLLBACK")
+	if _, err := waiter.ExecContext(ctx, timeout); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = waiter.ExecContext(ctx, fmt.Sprintf("UPDATE todos SET title = 'b' WHERE id = %d", a.ID))
+	wantCode(t, err, connect.CodeAborted, apierr.ReasonLockWaitTimeout)
+}
@@ -0,0 +1,615 @@
+// Package repositorytest checks that a storage backend behaves the way the
+// service expects, so that every backend passes the same tests.
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/internal/config"
+	"github.com/kogamitora/todo/internal/db"
+	"github.com/kogamitora/todo/internal/repository"
+	"github.com/kogamitora/todo/internal/repository/memory"
+	"github.com/kogamitora/todo/internal/repository/mysql"
+	"github.com/kogamitora/todo/internal/repository/postgres"
+	"github.com/kogamitora/todo/internal/repository/sqlite"
+)
+
+// Backend is a database the repositories are tested against.
+type Backend struct {
+	Name string
+	// Open returns the repositories of an empty, migrated database.
+	Open func(t *testing.T) repository.Repositories
+	// OpenDB is Open that also returns the database, for the tests that
+	// check its errors. It is nil for the in-memory store.
+	OpenDB func(t *testing.T) (*sql.DB, repository.Repositories)
+}
+
+// sqlBackend is a Backend whose repositories are built on a database.
+func sqlBackend(name string, dsn func(t *testing.T) string, repos func(*sql.DB) repository.Repositories) Backend {
+	openDB := func(t *testing.T) (*sql.DB, repository.Repositories) {
+		database := openDB(t, name, dsn(t))
+		return database, repos(database)
+	}
+	return Backend{
+		Name: name,
+		Open: func(t *testing.T) repository.Repositories {
+			_, r := openDB(t)
+			return r
+		},
+		OpenDB: openDB,
+	}
+}
+
+// Backends lists the databases to test: the in-memory store and SQLite
+// always, MySQL when TEST_MYSQL_DSN and PostgreSQL when TEST_POSTGRES_DSN
+// points to a database the tests may empty.
+func Backends(t *testing.T) []Backend {
+	list := []Backend{{
+		Name: config.DriverMemory,
+		Open: func(t *testing.T) repository.Repositories {
+			return memory.NewRepositories()
+		},
+	}, sqlBackend(config.DriverSQLite, func(t *testing.T) string {
+		return filepath.Join(t.TempDir(), "todo.db")
+	}, func(database *sql.DB) repository.Repositories {
+		return sqlite.NewRepositories(database)
+	})}
+
+	if dsn := os.Getenv("TEST_MYSQL_DSN"); dsn != "" {
+		list = append(list, sqlBackend(config.DriverMySQL, func(*testing.T) string { return dsn }, func(database *sql.DB) repository.Repositories {
+			return mysql.NewRepositories(database)
+		}))
+	} else {
+		t.Log("TEST_MYSQL_DSN is not set, skipping MySQL")
+	}
+
+	if dsn := os.Getenv("TEST_POSTGRES_DSN"); dsn != "" {
+		list = append(list, sqlBackend(config.DriverPostgres, func(*testing.T) string { return dsn }, func(database *sql.DB) repository.Repositories {
+			return postgres.NewRepositories(database)
+		}))
+	} else {
+		t.Log("TEST_POSTGRES_DSN is not set, skipping PostgreSQL")
+	}
+	return list
+}
+
+// openDB migrates the database at dsn, empties it and opens a pool that is
+// closed when the test ends.
+func openDB(t *testing.T, driver, dsn string) *sql.DB {
+	t.Helper()
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	mg, err := db.NewMigrator(ctx, driver, dsn, db.Migrations(driver, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = mg.Up(ctx)
+	mg.Close()
+	if err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	database, err := db.NewDB(dsn, config.DatabaseConfig{Driver: driver}, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { database.Close() })
+
+	for _, table := range []string{"attachments", "comments", "todo_dependencies", "todos"} {
+		if _, err := database.Exec("DELETE FROM " + table); err != nil {
+			t.Fatalf("failed to empty %s: %v", table, err)
+		}
+	}
+	return database
+}
+
+// Run runs the conformance tests, each against the repositories returned
+// by a fresh call to open.
+func Run(t *testing.T, open func(t *testing.T) repository.Repositories) {
+	for _, tt := range []struct {
+		name string
+		test func(t *testing.T, r repository.Repositories)
+	}{
+		{"TodoCRUD", testTodoCRUD},
+		{"SoftDelete", testSoftDelete},
+		{"ListFilter", testListFilter},
+		{"DueDateOrder", testDueDateOrder},
+		{"Positions", testPositions},
+		{"RenumberPositions", testRenumberPositions},
+		{"Counts", testCounts},
+		{"Dependencies", testDependencies},
+		{"Cycles", testCycles},
+		{"ConcurrentCycles", testConcurrentCycles},
+		{"Comments", testComments},
+		{"Attachments", testAttachments},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, open(t))
+		})
+	}
+}
+
+// day is a due date; MySQL keeps whole seconds only.
+var day = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func create(t *testing.T, r repository.Repositories, todo repository.Todo) *repository.Todo {
+	t.Helper()
+	if todo.Status == todov1.Status_STATUS_UNSPECIFIED {
+		todo.Status = todov1.Status_STATUS_INCOMPLETE
+	}
+	if err := r.Todos.Create(context.Background(), &todo); err != nil {
+		t.Fatalf("Create(%q): %v", todo.Title, err)
+	}
+	return &todo
+}
+
+func get(t *testing.T, r repository.Repositories, id int64) *repository.Todo {
+	t.Helper()
+	todo, err := r.Todos.Get(context.Background(), id)
+	if err != nil {
+		t.Fatalf("Get(%d): %v", id, err)
+	}
+	return todo
+}
+
+func titles(t *testing.T, r repository.Repositories, opts repository.ListOptions) []string {
+	t.Helper()
+	list, err := r.Todos.List(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("List(%+v): %v", opts, err)
+	}
+	var titles []string
+	for _, todo := range list {
+		titles = append(titles, todo.Title)
+	}
+	return titles
+}
+
+func wantErr(t *testing.T, err, want error) {
+	t.Helper()
+	if !errors.Is(err, want) {
+		t.Fatalf("error = %v, want %v", err, want)
+	}
+}
+
+func testTodoCRUD(t *testing.T, r repository.Repositories) {
+	ctx := context.Background()
+	created := create(t, r, repository.Todo{
+		Title:       "write tests",
+		Description: "for every backend",
+		DueDate:     day,
+		Position:    "a",
+		CreatedBy:   "alice",
+	})
+	if created.ID == 0 || created.CreatedAt.IsZero() || created.UpdatedAt.IsZero() {
+		t.Fatalf("Create did not set the ID and timestamps: %+v", created)
+	}
+
+	got, err := r.Todos.Get(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Title != "write tests" || got.Description != "for every backend" || !got.DueDate.Equal(day) ||
+		got.Status != todov1.Status_STATUS_INCOMPLETE || got.Position != "a" || got.CreatedBy != "alice" || got.Deleted() {
+		t.Errorf("Get = %+v", got)
+	}
+
+	got.Title = "write more tests"
+	got.Description = ""
+	got.DueDate = time.Time{}
+	got.Status = todov1.Status_STATUS_IN_PROGRESS
+	if err := r.Todos.Update(ctx, got); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	got, err = r.Todos.Get(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Title != "write more tests" || got.Description != "" || !got.DueDate.IsZero() || got.Status != todov1.Status_STATUS_IN_PROGRESS {
+		t.Errorf("Get after Update = %+v", got)
+	}
+
+	_, err = r.Todos.Get(ctx, created.ID+100)
+	wantErr(t, err, repository.ErrNotFound)
+}
+
+func testSoftDelete(t *testing.T, r repository.Repositories) {
+	ctx := context.Background()
+	todo := create(t, r, repository.Todo{Title: "a"})
+
+	// MySQL keeps updated_at to the second
+	time.Sleep(time.Second)
+	if err := r.Todos.Delete(ctx, todo.ID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if got := titles(t, r, repository.ListOptions{}); len(got) != 0 {
+		t.Errorf("deleted todo is listed: %v", got)
+	}
+	got, err := r.Todos.Get(ctx, todo.ID)
+	if err != nil {
+		t.Fatalf("Get of a deleted todo: %v", err)
+	}
+	if !got.Deleted() {
+		t.Errorf("Get of a deleted todo = %+v, want DeletedAt set", got)
+	}
+	if !got.UpdatedAt.After(todo.UpdatedAt) {
+		t.Errorf("UpdatedAt after Delete = %v, want after %v", got.UpdatedAt, todo.UpdatedAt)
+	}
+	deleted := got
+
+	time.Sleep(time.Second)
+	if err := r.Todos.Restore(ctx, todo.ID); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if got := titles(t, r, repository.ListOptions{}); !slices.Equal(got, []string{"a"}) {
+		t.Errorf("after Restore = %v, want [a]", got)
+	}
+	got, err = r.Todos.Get(ctx, todo.ID)
+	if err != nil {
+		t.Fatalf("Get after Restore: %v", err)
+	}
+	if !got.UpdatedAt.After(deleted.UpdatedAt) {
+		t.Errorf("UpdatedAt after Restore = %v, want after %v", got.UpdatedAt, deleted.UpdatedAt)
+	}
+
+	wantErr(t, r.Todos.Delete(ctx, todo.ID+100), repository.ErrNotFound)
+	wantErr(t, r.Todos.Restore(ctx, todo.ID+100), repository.ErrNotFound)
+}
+
+func testListFilter(t *testing.T, r repository.Repositories) {
+	ctx := context.Background()
+	create(t, r, repository.Todo{Title: "a", DueDate: day})
+	b := create(t, r, repository.Todo{Title: "b", DueDate: day.AddDate(0, 0, 1)})
+	create(t, r, repository.Todo{Title: "c", DueDate: day.AddDate(0, 0, 2)})
+
+	b.Status = todov1.Status_STATUS_COMPLETED
+	if err := r.Todos.Update(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+	if got := titles(t, r, repository.ListOptions{Status: todov1.Status_STATUS_COMPLETED}); !slices.Equal(got, []string{"b"}) {
+		t.Errorf("completed = %v, want [b]", got)
+	}
+
+	page := repository.ListOptions{Sort: repository.SortDueDateAsc, Limit: 1, Offset: 1}
+	if got := titles(t, r, page); !slices.Equal(got, []string{"b"}) {
+		t.Errorf("limit 1 offset 1 = %v, want [b]", got)
+	}
+	page = repository.ListOptions{Sort: repository.SortDueDateAsc, Offset: 1}
+	if got := titles(t, r, page); !slices.Equal(got, []string{"b", "c"}) {
+		t.Errorf("offset 1 = %v, want [b c]", got)
+	}
+}
+
+func testDueDateOrder(t *testing.T, r repository.Repositories) {
+	create(t, r, repository.Todo{Title: "none"})
+	create(t, r, repository.Todo{Title: "late", DueDate: day.AddDate(0, 0, 2)})
+	create(t, r, repository.Todo{Title: "early", DueDate: day})
+
+	if got, want := titles(t, r, repository.ListOptions{Sort: repository.SortDueDateAsc}), []string{"early", "late", "none"}; !slices.Equal(got, want) {
+		t.Errorf("ascending = %v, want %v", got, want)
+	}
+	if got, want := titles(t, r, repository.ListOptions{Sort: repository.SortDueDateDesc}), []string{"none", "late", "early"}; !slices.Equal(got, want) {
+		t.Errorf("descending = %v, want %v", got, want)
+	}
+}
+
+func testPositions(t *testing.T, r repository.Repositories) {
+	ctx := context.Background()
+	incomplete := todov1.Status_STATUS_INCOMPLETE
+
+	last, err := r.Todos.LastPosition(ctx, incomplete)
+	if err != nil || last != "" {
+		t.Fatalf("LastPosition of an empty column = %q, %v", last, err)
+	}
+	// positions compare as bytes: "B" sorts before "a"
+	a := create(t, r, repository.Todo{Title: "a", Position: "a"})
+	create(t, r, repository.Todo{Title: "B", Position: "B"})
+	create(t, r, repository.Todo{Title: "c", Position: "c"})
+	create(t, r, repository.Todo{Title: "done", Position: "z", Status: todov1.Status_STATUS_COMPLETED})
+
+	if last, err = r.Todos.LastPosition(ctx, incomplete); err != nil || last != "c" {
+		t.Errorf("LastPosition = %q, %v, want c", last, err)
+	}
+	if got, err := r.Todos.NeighborPosition(ctx, incomplete, "a", a.ID, true); err != nil || got != "B" {
+		t.Errorf("position before a = %q, %v, want B", got, err)
+	}
+	if got, err := r.Todos.NeighborPosition(ctx, incomplete, "a", a.ID, false); err != nil || got != "c" {
+		t.Errorf("position after a = %q, %v, want c", got, err)
+	}
+	if got, err := r.Todos.NeighborPosition(ctx, incomplete, "c", 0, false); err != nil || got != "" {
+		t.Errorf("position after c = %q, %v, want none", got, err)
+	}
+
+	manual := titles(t, r, repository.ListOptions{Sort: repository.SortManual, Status: incomplete})
+	if want := []string{"B", "a", "c"}; !slices.Equal(manual, want) {
+		t.Errorf("manual order = %v, want %v", manual, want)
+	}
+
+	// without a status filter the columns follow the order of the enum,
+	// not the alphabetical order of the stored values
+	create(t, r, repository.Todo{Title: "doing", Position: "a", Status: todov1.Status_STATUS_IN_PROGRESS})
+	manual = titles(t, r, repository.ListOptions{Sort: repository.SortManual})
+	if want := []string{"B", "a", "c", "done", "doing"}; !slices.Equal(manual, want) {
+		t.Errorf("manual order of every column = %v, want %v", manual, want)
+	}
+}
+
+func testRenumberPositions(t *testing.T, r repository.Repositories) {
+	ctx := context.Background()
+	incomplete := todov1.Status_STATUS_INCOMPLETE
+	// b and c share a position, which the IDs order
+	c := create(t, r, repository.Todo{Title: "c", Position: "000000000002V"})
+	b := create(t, r, repository.Todo{Title: "b", Position: "000000000002V"})
+	a := create(t, r, repository.Todo{Title: "a", Position: "000000000001V"})
+	if err := r.Todos.Delete(ctx, a.ID); err != nil {
+		t.Fatal(err)
+	}
+	done := create(t, r, repository.Todo{Title: "done", Position: "z", Status: todov1.Status_STATUS_COMPLETED})
+	c, b, done = get(t, r, c.ID), get(t, r, b.ID), get(t, r, done.ID)
+
+	var n int
+	err := r.Todos.RenumberPositions(ctx, incomplete, func(count int) []string {
+		n = count
+		return []string{"p1", "p2", "p3"}[:count]
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Errorf("renumbered %d todos, want 3 including the deleted one", n)
+	}
+	for _, want := range []struct {
+		todo     *repository.Todo
+		position string
+	}{{a, "p1"}, {c, "p2"}, {b, "p3"}, {done, "z"}} {
+		got := get(t, r, want.todo.ID)
+		if got.Position != want.position {
+			t.Errorf("position of %s = %q, want %q", got.Title, got.Position, want.position)
+		}
+		if want.todo != a && !got.UpdatedAt.Equal(want.todo.UpdatedAt) {
+			t.Errorf("UpdatedAt of %s changed from %v to %v", got.Title, want.todo.UpdatedAt, got.UpdatedAt)
+		}
+	}
+}
+
+func testCounts(t *testing.T, r repository.Repositories) {
+	ctx := context.Background()
+	now := time.Now()
+	create(t, r, repository.Todo{Title: "overdue", CreatedBy: "alice", DueDate: now.Add(-time.Hour).Truncate(time.Second)})
+	create(t, r, repository.Todo{Title: "due later", CreatedBy: "alice", DueDate: now.Add(time.Hour).Truncate(time.Second)})
+	create(t, r, repository.Todo{Title: "done", DueDate: now.Add(-time.Hour).Truncate(time.Second), Status: todov1.Status_STATUS_COMPLETED})
+	deleted := create(t, r, repository.Todo{Title: "deleted", CreatedBy: "alice"})
+	if err := r.Todos.Delete(ctx, deleted.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if n, err := r.Todos.CountOwned(ctx, "alice"); err != nil || n != 2 {
+		t.Errorf("CountOwned(alice) = %d, %v, want 2", n, err)
+	}
+	if n, err := r.Todos.CountOwned(ctx, ""); err != nil || n != 1 {
+		t.Errorf("CountOwned without a user = %d, %v, want 1", n, err)
+	}
+	open, overdue, err := r.Todos.CountOpen(ctx, now)
+	if err != nil || open != 2 || overdue != 1 {
+		t.Errorf("CountOpen = %d, %d, %v, want 2 open and 1 overdue", open, overdue, err)
+	}
+}
+
+func testDependencies(t *testing.T, r repository.Repositories) {
+	ctx := context.Background()
+	a := create(t, r, repository.Todo{Title: "a", DueDate: day})
+	b := create(t, r, repository.Todo{Title: "b", DueDate: day.AddDate(0, 0, 1)})
+	c := create(t, r, repository.Todo{Title: "c", DueDate: day.AddDate(0, 0, 2)})
+
+	for _, dep := range []repository.Dependency{{TodoID: a.ID, BlockedByID: c.ID}, {TodoID: a.ID, BlockedByID: b.ID}} {
+		if err := r.Dependencies.Add(ctx, dep); err != nil {
+			t.Fatalf("Add(%+v): %v", dep, err)
+		}
+	}
+	wantErr(t, r.Dependencies.Add(ctx, repository.Dependency{TodoID: a.ID, BlockedByID: b.ID}), repository.ErrAlreadyExists)
+
+	blockers, err := r.Dependencies.Blockers(ctx, a.ID, b.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []repository.Dependency{{TodoID: a.ID, BlockedByID: b.ID}, {TodoID: a.ID, BlockedByID: c.ID}}
+	if !slices.Equal(blockers, want) {
+		t.Errorf("Blockers = %v, want %v", blockers, want)
+	}
+	if got := titles(t, r, repository.ListOptions{Dependency: todov1.DependencyFilter_DEPENDENCY_FILTER_BLOCKED}); !slices.Equal(got, []string{"a"}) {
+		t.Errorf("blocked = %v, want [a]", got)
+	}
+
+	// a deleted blocker no longer counts, a finished one is still listed
+	if err := r.Todos.Delete(ctx, c.ID); err != nil {
+		t.Fatal(err)
+	}
+	b.Status = todov1.Status_STATUS_COMPLETED
+	if err := r.Todos.Update(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+	active, err := r.Dependencies.ActiveBlockers(ctx, a.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []repository.Dependency{{TodoID: a.ID, BlockedByID: b.ID}}; !slices.Equal(active, want) {
+		t.Errorf("ActiveBlockers = %v, want %v", active, want)
+	}
+	if n, err := r.Dependencies.CountOpenBlockers(ctx, a.ID); err != nil || n != 0 {
+		t.Errorf("CountOpenBlockers = %d, %v, want 0", n, err)
+	}
+	if got := titles(t, r, repository.ListOptions{Dependency: todov1.DependencyFilter_DEPENDENCY_FILTER_READY, Sort: repository.SortDueDateAsc}); !slices.Equal(got, []string{"a", "b"}) {
+		t.Errorf("ready = %v, want [a b]", got)
+	}
+
+	if err := r.Dependencies.Remove(ctx, repository.Dependency{TodoID: a.ID, BlockedByID: b.ID}); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+	wantErr(t, r.Dependencies.Remove(ctx, repository.Dependency{TodoID: a.ID, BlockedByID: b.ID}), repository.ErrNotFound)
+}
+
+func testCycles(t *testing.T, r repository.Repositories) {
+	ctx := context.Background()
+	a := create(t, r, repository.Todo{Title: "a"})
+	b := create(t, r, repository.Todo{Title: "b"})
+	c := create(t, r, repository.Todo{Title: "c"})
+
+	wantErr(t, r.Dependencies.Add(ctx, repository.Dependency{TodoID: a.ID, BlockedByID: a.ID}), repository.ErrCycle)
+	for _, dep := range []repository.Dependency{{TodoID: a.ID, BlockedByID: b.ID}, {TodoID: b.ID, BlockedByID: c.ID}} {
+		if err := r.Dependencies.Add(ctx, dep); err != nil {
+			t.Fatalf("Add(%+v): %v", dep, err)
+		}
+	}
+	// a duplicate is reported as such, even though a -> b -> a is also a cycle
+	wantErr(t, r.Dependencies.Add(ctx, repository.Dependency{TodoID: a.ID, BlockedByID: b.ID}), repository.ErrAlreadyExists)
+	wantErr(t, r.Dependencies.Add(ctx, repository.Dependency{TodoID: b.ID, BlockedByID: a.ID}), repository.ErrCycle)
+	wantErr(t, r.Dependencies.Add(ctx, repository.Dependency{TodoID: c.ID, BlockedByID: a.ID}), repository.ErrCycle)
+	// a shortcut is no cycle
+	if err := r.Dependencies.Add(ctx, repository.Dependency{TodoID: a.ID, BlockedByID: c.ID}); err != nil {
+		t.Fatalf("Add(a, c): %v", err)
+	}
+
+	// the rejected dependencies were not stored
+	deps, err := r.Dependencies.Blockers(ctx, a.ID, b.ID, c.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []repository.Dependency{{TodoID: a.ID, BlockedByID: b.ID}, {TodoID: a.ID, BlockedByID: c.ID}, {TodoID: b.ID, BlockedByID: c.ID}}
+	if !slices.Equal(deps, want) {
+		t.Errorf("Blockers = %v, want %v", deps, want)
+	}
+}
+
+// testConcurrentCycles adds, at the same time, two dependencies that close
+// a cycle together. Whatever the timing, at most one may be stored.
+func testConcurrentCycles(t *testing.T, r repository.Repositories) {
+	ctx := context.Background()
+	for i := range 20 {
+		a := create(t, r, repository.Todo{Title: "a"})
+		b := create(t, r, repository.Todo{Title: "b"})
+		c := create(t, r, repository.Todo{Title: "c"})
+		if err := r.Dependencies.Add(ctx, repository.Dependency{TodoID: a.ID, BlockedByID: b.ID}); err != nil {
+			t.Fatal(err)
+		}
+		// b -> c and c -> a close a -> b -> c -> a
+		racing := []repository.Dependency{{TodoID: b.ID, BlockedByID: c.ID}, {TodoID: c.ID, BlockedByID: a.ID}}
+		errs := make([]error, len(racing))
+		var wg sync.WaitGroup
+		for j, dep := range racing {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[j] = r.Dependencies.Add(ctx, dep)
+			}()
+		}
+		wg.Wait()
+
+		if errs[0] == nil && errs[1] == nil {
+			t.Fatalf("round %d: both dependencies of a cycle were added", i)
+		}
+		// the loser fails with ErrCycle, or, in MySQL, with a deadlock
+		deps, err := r.Dependencies.Blockers(ctx, a.ID, b.ID, c.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(deps) == 3 {
+			t.Fatalf("round %d: stored a cycle %v (errors %v)", i, deps, errs)
+		}
+	}
+}
+
+func testComments(t *testing.T, r repository.Repositories) {
+	ctx := context.Background()
+	todo := create(t, r, repository.Todo{Title: "a"})
+
+	var ids []int64
+	for _, body := range []string{"first", "second", "third"} {
+		c := &repository.Comment{TodoID: todo.ID, Author: "alice", Body: body}
+		if err := r.Comments.Create(ctx, c); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		if c.ID == 0 || c.CreatedAt.IsZero() {
+			t.Fatalf("Create did not set the ID and timestamps: %+v", c)
+		}
+		ids = append(ids, c.ID)
+	}
+
+	first, err := r.Comments.Get(ctx, ids[0])
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	first.Body = "edited"
+	if err := r.Comments.Update(ctx, first); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if err := r.Comments.Delete(ctx, ids[1]); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	_, err = r.Comments.Get(ctx, ids[1])
+	wantErr(t, err, repository.ErrNotFound)
+	wantErr(t, r.Comments.Delete(ctx, ids[1]), repository.ErrNotFound)
+
+	bodies := func(limit int) []string {
+		t.Helper()
+		list, err := r.Comments.List(ctx, todo.ID, limit)
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		var bodies []string
+		for _, c := range list {
+			bodies = append(bodies, c.Body)
+		}
+		return bodies
+	}
+	if got, want := bodies(0), []string{"edited", "third"}; !slices.Equal(got, want) {
+		t.Errorf("List = %v, want %v", got, want)
+	}
+	if got, want := bodies(1), []string{"third"}; !slices.Equal(got, want) {
+		t.Errorf("List with limit 1 = %v, want %v", got, want)
+	}
+}
+
+func testAttachments(t *testing.T, r repository.Repositories) {
+	ctx := context.Background()
+	todo := create(t, r, repository.Todo{Title: "a"})
+
+	sum := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	var ids []int64
+	for _, name := range []string{"a.txt", "b.txt"} {
+		a := &repository.Attachment{TodoID: todo.ID, Filename: name, ContentType: "text/plain", Size: 4, Sha256: sum}
+		if err := r.Attachments.Create(ctx, a); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		ids = append(ids, a.ID)
+	}
+
+	got, err := r.Attachments.Get(ctx, ids[0])
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Filename != "a.txt" || got.ContentType != "text/plain" || got.Size != 4 || got.Sha256 != sum || got.CreatedAt.IsZero() {
+		t.Errorf("Get = %+v", got)
+	}
+	_, err = r.Attachments.Get(ctx, ids[1]+100)
+	wantErr(t, err, repository.ErrNotFound)
+
+	list, err := r.Attachments.List(ctx, todo.ID)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(list) != 2 || list[0].ID != ids[0] || list[1].ID != ids[1] {
+		t.Errorf("List = %v, want attachments %v", list, ids)
+	}
+}
@@ -0,0 +1,45 @@
+package repositorytest
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/kogamitora/todo/internal/repository"
+	"github.com/kogamitora/todo/models"
+	"github.com/kogamitora/todo/pgmodels"
+)
+
+func TestConformance(t *testing.T) {
+	for _, b := range Backends(t) {
+		t.Run(b.Name, func(t *testing.T) {
+			Run(t, b.Open)
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	for _, b := range Backends(t) {
+		if b.OpenDB == nil {
+			continue
+		}
+		t.Run(b.Name, func(t *testing.T) {
+			RunErrors(t, b.Name, b.OpenDB)
+		})
+	}
+}
+
+// TestStatusValues checks repository.Statuses against the ENUM types of the
+// MySQL and PostgreSQL schemas, in order, since the manual order sorts by
+// them. TODO_STATUS_UNSPECIFIED is never stored.
+func TestStatusValues(t *testing.T) {
+	values := []string{"TODO_STATUS_UNSPECIFIED"}
+	for _, info := range repository.Statuses {
+		values = append(values, info.Value)
+	}
+	if got := models.AllTodosStatus(); !slices.Equal(got, values) {
+		t.Errorf("MySQL statuses = %v, want %v", got, values)
+	}
+	if got := pgmodels.AllTodoStatus(); !slices.Equal(got, values) {
+		t.Errorf("PostgreSQL statuses = %v, want %v", got, values)
+	}
+}
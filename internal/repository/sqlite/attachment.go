@@ -0,0 +1,78 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/kogamitora/todo/internal/repository"
+)
+
+// AttachmentRepository implements repository.AttachmentRepository.
+type AttachmentRepository struct {
+	db Executor
+}
+
+var _ repository.AttachmentRepository = (*AttachmentRepository)(nil)
+
+func NewAttachmentRepository(db Executor) *AttachmentRepository {
+	return &AttachmentRepository{db: db}
+}
+
+const attachmentColumns = "`id`, `todo_id`, `filename`, `content_type`, `size`, `sha256`, `created_at`"
+
+func scanAttachment(row scanner) (*repository.Attachment, error) {
+	var a repository.Attachment
+	if err := row.Scan(&a.ID, &a.TodoID, &a.Filename, &a.ContentType, &a.Size, &a.Sha256, &a.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (r *AttachmentRepository) Get(ctx context.Context, id int64) (*repository.Attachment, error) {
+	attachment, err := scanAttachment(r.db.QueryRowContext(ctx,
+		"SELECT "+attachmentColumns+" FROM `attachments` WHERE `id` = ? AND `deleted_at` IS NULL", id,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	return attachment, err
+}
+
+func (r *AttachmentRepository) List(ctx context.Context, todoID int64) ([]*repository.Attachment, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+attachmentColumns+" FROM `attachments` WHERE `todo_id` = ? AND `deleted_at` IS NULL ORDER BY `id` ASC", todoID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*repository.Attachment
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, attachment)
+	}
+	return list, rows.Err()
+}
+
+func (r *AttachmentRepository) Create(ctx context.Context, attachment *repository.Attachment) error {
+	created := now()
+	res, err := r.db.ExecContext(ctx,
+		"INSERT INTO `attachments` (`todo_id`, `filename`, `content_type`, `size`, `sha256`, `created_at`) VALUES (?, ?, ?, ?, ?, ?)",
+		attachment.TodoID, attachment.Filename, attachment.ContentType, attachment.Size, attachment.Sha256, created,
+	)
+	if err != nil {
+		return err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	attachment.ID = id
+	attachment.CreatedAt = created
+	return nil
+}
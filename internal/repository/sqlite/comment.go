@@ -0,0 +1,114 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+
+	"github.com/kogamitora/todo/internal/repository"
+)
+
+// CommentRepository implements repository.CommentRepository.
+type CommentRepository struct {
+	db Executor
+}
+
+var _ repository.CommentRepository = (*CommentRepository)(nil)
+
+func NewCommentRepository(db Executor) *CommentRepository {
+	return &CommentRepository{db: db}
+}
+
+const commentColumns = "`id`, `todo_id`, `author`, `body`, `created_at`, `updated_at`"
+
+func scanComment(row scanner) (*repository.Comment, error) {
+	var c repository.Comment
+	if err := row.Scan(&c.ID, &c.TodoID, &c.Author, &c.Body, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *CommentRepository) Get(ctx context.Context, id int64) (*repository.Comment, error) {
+	comment, err := scanComment(r.db.QueryRowContext(ctx,
+		"SELECT "+commentColumns+" FROM `comments` WHERE `id` = ? AND `deleted_at` IS NULL", id,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	return comment, err
+}
+
+func (r *CommentRepository) List(ctx context.Context, todoID int64, limit int) ([]*repository.Comment, error) {
+	query := "SELECT " + commentColumns + " FROM `comments` WHERE `todo_id` = ? AND `deleted_at` IS NULL"
+	args := []any{todoID}
+	if limit > 0 {
+		// newest first so LIMIT keeps the latest ones; reversed below
+		query += " ORDER BY `created_at` DESC, `id` DESC LIMIT ?"
+		args = append(args, limit)
+	} else {
+		query += " ORDER BY `created_at` ASC, `id` ASC"
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*repository.Comment
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, comment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if limit > 0 {
+		slices.Reverse(list)
+	}
+	return list, nil
+}
+
+func (r *CommentRepository) Create(ctx context.Context, comment *repository.Comment) error {
+	created := now()
+	res, err := r.db.ExecContext(ctx,
+		"INSERT INTO `comments` (`todo_id`, `author`, `body`, `created_at`, `updated_at`) VALUES (?, ?, ?, ?, ?)",
+		comment.TodoID, comment.Author, comment.Body, created, created,
+	)
+	if err != nil {
+		return err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	comment.ID = id
+	comment.CreatedAt = created
+	comment.UpdatedAt = created
+	return nil
+}
+
+func (r *CommentRepository) Update(ctx context.Context, comment *repository.Comment) error {
+	updated := now()
+	res, err := r.db.ExecContext(ctx,
+		"UPDATE `comments` SET `body` = ?, `updated_at` = ? WHERE `id` = ? AND `deleted_at` IS NULL",
+		comment.Body, updated, comment.ID,
+	)
+	if err := notFoundIfNone(res, err); err != nil {
+		return err
+	}
+	comment.UpdatedAt = updated
+	return nil
+}
+
+func (r *CommentRepository) Delete(ctx context.Context, id int64) error {
+	res, err := r.db.ExecContext(ctx,
+		"UPDATE `comments` SET `deleted_at` = ? WHERE `id` = ? AND `deleted_at` IS NULL", now(), id,
+	)
+	return notFoundIfNone(res, err)
+}
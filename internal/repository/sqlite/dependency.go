@@ -0,0 +1,145 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/internal/repository"
+)
+
+// DependencyRepository implements repository.DependencyRepository.
+type DependencyRepository struct {
+	db DB
+}
+
+var _ repository.DependencyRepository = (*DependencyRepository)(nil)
+
+func NewDependencyRepository(db DB) *DependencyRepository {
+	return &DependencyRepository{db: db}
+}
+
+// finishedStatuses are the stored values of the statuses in which a blocker
+// no longer blocks.
+var finishedStatuses = statusValues(todov1.Status_STATUS_COMPLETED, todov1.Status_STATUS_CANCELLED)
+
+// statusValues returns the stored values of statuses, as query arguments.
+func statusValues(statuses ...todov1.Status) []any {
+	values := make([]any, len(statuses))
+	for i, s := range statuses {
+		v, _ := repository.StatusValue(s)
+		values[i] = v
+	}
+	return values
+}
+
+// openBlockerExists matches todos that have at least one blocker which is
+// neither deleted nor finished; it takes finishedStatuses as arguments.
+const openBlockerExists = "EXISTS (SELECT 1 FROM `todo_dependencies` d" +
+	" INNER JOIN `todos` b ON b.`id` = d.`blocked_by_id`" +
+	" WHERE d.`todo_id` = `todos`.`id` AND b.`deleted_at` IS NULL AND b.`status` NOT IN (?, ?))"
+
+// Add inserts first and then looks for a cycle, so that the transaction
+// holds the database's write lock during the check and concurrent Adds
+// run one after the other.
+func (r *DependencyRepository) Add(ctx context.Context, dep repository.Dependency) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		"INSERT INTO `todo_dependencies` (`todo_id`, `blocked_by_id`, `created_at`) VALUES (?, ?, ?) ON CONFLICT DO NOTHING",
+		dep.TodoID, dep.BlockedByID, now(),
+	)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repository.ErrAlreadyExists
+	}
+	cycle, err := repository.Reaches(ctx, dep.BlockedByID, dep.TodoID, func(ctx context.Context, todoIDs ...int64) ([]repository.Dependency, error) {
+		return blockers(ctx, tx, todoIDs, "")
+	})
+	if err != nil {
+		return err
+	}
+	if cycle {
+		return repository.ErrCycle
+	}
+	return tx.Commit()
+}
+
+func (r *DependencyRepository) Remove(ctx context.Context, dep repository.Dependency) error {
+	res, err := r.db.ExecContext(ctx,
+		"DELETE FROM `todo_dependencies` WHERE `todo_id` = ? AND `blocked_by_id` = ?",
+		dep.TodoID, dep.BlockedByID,
+	)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+func (r *DependencyRepository) Blockers(ctx context.Context, todoIDs ...int64) ([]repository.Dependency, error) {
+	return blockers(ctx, r.db, todoIDs, "")
+}
+
+func (r *DependencyRepository) ActiveBlockers(ctx context.Context, todoIDs ...int64) ([]repository.Dependency, error) {
+	return blockers(ctx, r.db, todoIDs, " AND b.`deleted_at` IS NULL")
+}
+
+// blockers returns the dependencies of todoIDs that also match cond.
+func blockers(ctx context.Context, db Executor, todoIDs []int64, cond string) ([]repository.Dependency, error) {
+	if len(todoIDs) == 0 {
+		return nil, nil
+	}
+	args := make([]any, len(todoIDs))
+	for i, id := range todoIDs {
+		args[i] = id
+	}
+	rows, err := db.QueryContext(ctx,
+		"SELECT d.`todo_id`, d.`blocked_by_id` FROM `todo_dependencies` d"+
+			" INNER JOIN `todos` b ON b.`id` = d.`blocked_by_id`"+
+			" WHERE d.`todo_id` IN (?"+strings.Repeat(", ?", len(todoIDs)-1)+")"+cond+
+			" ORDER BY d.`todo_id`, d.`blocked_by_id`",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []repository.Dependency
+	for rows.Next() {
+		var d repository.Dependency
+		if err := rows.Scan(&d.TodoID, &d.BlockedByID); err != nil {
+			return nil, err
+		}
+		list = append(list, d)
+	}
+	return list, rows.Err()
+}
+
+func (r *DependencyRepository) CountOpenBlockers(ctx context.Context, todoID int64) (int64, error) {
+	var count int64
+	err := r.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM `todo_dependencies` d"+
+			" INNER JOIN `todos` b ON b.`id` = d.`blocked_by_id`"+
+			" WHERE d.`todo_id` = ? AND b.`deleted_at` IS NULL AND b.`status` NOT IN (?, ?)",
+		append([]any{todoID}, finishedStatuses...)...,
+	).Scan(&count)
+	return count, err
+}
@@ -0,0 +1,327 @@
+// Package sqlite stores todos in a SQLite database file.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/internal/repository"
+)
+
+// Executor is implemented by *sql.DB, *sql.Conn and *sql.Tx.
+type Executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// DB is an Executor that starts transactions, such as *sql.DB.
+type DB interface {
+	Executor
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// TodoRepository implements repository.TodoRepository.
+type TodoRepository struct {
+	db DB
+}
+
+var _ repository.TodoRepository = (*TodoRepository)(nil)
+
+func NewTodoRepository(db DB) *TodoRepository {
+	return &TodoRepository{db: db}
+}
+
+// NewRepositories returns all the repositories of db.
+func NewRepositories(db DB) repository.Repositories {
+	return repository.Repositories{
+		Todos:        NewTodoRepository(db),
+		Dependencies: NewDependencyRepository(db),
+		Comments:     NewCommentRepository(db),
+		Attachments:  NewAttachmentRepository(db),
+	}
+}
+
+// statusOrder sorts by the number of the status in the enum, as the ENUM
+// types of MySQL and PostgreSQL do, rather than by the stored text.
+var statusOrder = func() string {
+	var b strings.Builder
+	b.WriteString("CASE `status`")
+	for _, info := range repository.Statuses {
+		fmt.Fprintf(&b, " WHEN '%s' THEN %d", info.Value, info.Status)
+	}
+	b.WriteString(" END")
+	return b.String()
+}()
+
+// nullTime stores zero as NULL. Times are stored in UTC so that they sort
+// as text.
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t.UTC(), Valid: !t.IsZero()}
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// now returns the current time in UTC, the zone times are stored in.
+func now() time.Time {
+	return time.Now().UTC()
+}
+
+const todoColumns = "`id`, `title`, `description`, `due_date`, `status`, `position`, `created_by`, `created_at`, `updated_at`, `deleted_at`"
+
+// scanner is implemented by *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTodo(row scanner) (*repository.Todo, error) {
+	var (
+		t                            repository.Todo
+		description, status, creator sql.NullString
+		dueDate, deletedAt           sql.NullTime
+	)
+	err := row.Scan(&t.ID, &t.Title, &description, &dueDate, &status, &t.Position, &creator, &t.CreatedAt, &t.UpdatedAt, &deletedAt)
+	if err != nil {
+		return nil, err
+	}
+	t.Description = description.String
+	t.DueDate = dueDate.Time
+	t.Status = repository.StatusFromValue(status.String)
+	t.CreatedBy = creator.String
+	t.DeletedAt = deletedAt.Time
+	return &t, nil
+}
+
+func (r *TodoRepository) Get(ctx context.Context, id int64) (*repository.Todo, error) {
+	todo, err := scanTodo(r.db.QueryRowContext(ctx, "SELECT "+todoColumns+" FROM `todos` WHERE `id` = ?", id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrNotFound
+	}
+	return todo, err
+}
+
+func (r *TodoRepository) List(ctx context.Context, opts repository.ListOptions) ([]*repository.Todo, error) {
+	where := []string{"`deleted_at` IS NULL"}
+	var args []any
+
+	if opts.Status != todov1.Status_STATUS_UNSPECIFIED {
+		status, err := repository.StatusValue(opts.Status)
+		if err != nil {
+			return nil, err
+		}
+		where = append(where, "`status` = ?")
+		args = append(args, status)
+	}
+	switch opts.Dependency {
+	case todov1.DependencyFilter_DEPENDENCY_FILTER_READY:
+		where = append(where, "NOT "+openBlockerExists)
+		args = append(args, finishedStatuses...)
+	case todov1.DependencyFilter_DEPENDENCY_FILTER_BLOCKED:
+		where = append(where, openBlockerExists)
+		args = append(args, finishedStatuses...)
+	}
+
+	// SQLite sorts NULL first in ascending order, like MySQL
+	var order string
+	switch opts.Sort {
+	case repository.SortManual:
+		// positions are only comparable within a status column
+		order = statusOrder + ", `position` ASC, `id` ASC"
+	case repository.SortDueDateAsc:
+		order = "`due_date` IS NULL, `due_date` ASC"
+	case repository.SortDueDateDesc:
+		order = "`due_date` IS NULL DESC, `due_date` DESC"
+	default:
+		order = "`created_at` DESC"
+	}
+
+	query := "SELECT " + todoColumns + " FROM `todos` WHERE " + strings.Join(where, " AND ") + " ORDER BY " + order
+	if opts.Limit > 0 || opts.Offset > 0 {
+		// a negative limit means no limit
+		limit := -1
+		if opts.Limit > 0 {
+			limit = opts.Limit
+		}
+		query += " LIMIT ? OFFSET ?"
+		args = append(args, limit, opts.Offset)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*repository.Todo
+	for rows.Next() {
+		todo, err := scanTodo(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, todo)
+	}
+	return list, rows.Err()
+}
+
+func (r *TodoRepository) Create(ctx context.Context, todo *repository.Todo) error {
+	status, err := repository.StatusValue(todo.Status)
+	if err != nil {
+		return err
+	}
+	created := now()
+	res, err := r.db.ExecContext(ctx,
+		"INSERT INTO `todos` (`title`, `description`, `due_date`, `status`, `position`, `created_by`, `created_at`, `updated_at`)"+
+			" VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		todo.Title, nullString(todo.Description), nullTime(todo.DueDate), status, todo.Position, nullString(todo.CreatedBy), created, created,
+	)
+	if err != nil {
+		return err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	todo.ID = id
+	todo.CreatedAt = created
+	todo.UpdatedAt = created
+	return nil
+}
+
+func (r *TodoRepository) Update(ctx context.Context, todo *repository.Todo) error {
+	status, err := repository.StatusValue(todo.Status)
+	if err != nil {
+		return err
+	}
+	updated := now()
+	_, err = r.db.ExecContext(ctx,
+		"UPDATE `todos` SET `title` = ?, `description` = ?, `due_date` = ?, `status` = ?, `position` = ?, `updated_at` = ? WHERE `id` = ?",
+		todo.Title, nullString(todo.Description), nullTime(todo.DueDate), status, todo.Position, updated, todo.ID,
+	)
+	if err != nil {
+		return err
+	}
+	todo.UpdatedAt = updated
+	return nil
+}
+
+func (r *TodoRepository) Delete(ctx context.Context, id int64) error {
+	return r.setDeletedAt(ctx, id, nullTime(now()))
+}
+
+func (r *TodoRepository) Restore(ctx context.Context, id int64) error {
+	return r.setDeletedAt(ctx, id, sql.NullTime{})
+}
+
+func (r *TodoRepository) setDeletedAt(ctx context.Context, id int64, deletedAt sql.NullTime) error {
+	res, err := r.db.ExecContext(ctx, "UPDATE `todos` SET `deleted_at` = ?, `updated_at` = ? WHERE `id` = ?", deletedAt, now(), id)
+	return notFoundIfNone(res, err)
+}
+
+// notFoundIfNone returns ErrNotFound if an UPDATE matched no row. Unlike
+// MySQL, SQLite counts the rows matched, changed or not.
+func notFoundIfNone(res sql.Result, err error) error {
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+func (r *TodoRepository) CountOwned(ctx context.Context, owner string) (int64, error) {
+	var count int64
+	err := r.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM `todos` WHERE `created_by` IS ? AND `deleted_at` IS NULL", nullString(owner),
+	).Scan(&count)
+	return count, err
+}
+
+func (r *TodoRepository) CountOpen(ctx context.Context, now time.Time) (open, overdue int64, err error) {
+	err = r.db.QueryRowContext(ctx,
+		"SELECT COUNT(*), COUNT(CASE WHEN `due_date` < ? THEN 1 END) FROM `todos`"+
+			" WHERE `deleted_at` IS NULL AND `status` NOT IN (?, ?)",
+		append([]any{nullTime(now)}, finishedStatuses...)...,
+	).Scan(&open, &overdue)
+	return open, overdue, err
+}
+
+func (r *TodoRepository) LastPosition(ctx context.Context, status todov1.Status) (string, error) {
+	s, err := repository.StatusValue(status)
+	if err != nil {
+		return "", err
+	}
+	var last string
+	err = r.db.QueryRowContext(ctx,
+		"SELECT `position` FROM `todos` WHERE `status` = ? ORDER BY `position` DESC LIMIT 1", s,
+	).Scan(&last)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	return last, err
+}
+
+func (r *TodoRepository) NeighborPosition(ctx context.Context, status todov1.Status, position string, excludeID int64, before bool) (string, error) {
+	s, err := repository.StatusValue(status)
+	if err != nil {
+		return "", err
+	}
+	query := "SELECT `position` FROM `todos` WHERE `status` = ? AND `id` <> ? AND `position` > ? ORDER BY `position` ASC LIMIT 1"
+	if before {
+		query = "SELECT `position` FROM `todos` WHERE `status` = ? AND `id` <> ? AND `position` < ? ORDER BY `position` DESC LIMIT 1"
+	}
+	var neighbor string
+	err = r.db.QueryRowContext(ctx, query, s, excludeID, position).Scan(&neighbor)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	return neighbor, err
+}
+
+func (r *TodoRepository) RenumberPositions(ctx context.Context, status todov1.Status, keys func(n int) []string) error {
+	s, err := repository.StatusValue(status)
+	if err != nil {
+		return err
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, "SELECT `id` FROM `todos` WHERE `status` = ? ORDER BY `position`, `id`", s)
+	if err != nil {
+		return err
+	}
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for i, position := range keys(len(ids)) {
+		if _, err := tx.ExecContext(ctx, "UPDATE `todos` SET `position` = ? WHERE `id` = ?", position, ids[i]); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"fmt"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+)
+
+// StatusInfo gives the names of a status outside of protobuf.
+type StatusInfo struct {
+	Status todov1.Status
+	// Name is the short name used in configuration, such as
+	// STATUS_TRANSITIONS.
+	Name string
+	// Value is the value stored in todos.status by the SQL databases.
+	Value string
+}
+
+// Statuses lists every status a todo can have, in the order of the enum,
+// which is also the order of the ENUM types of the MySQL and PostgreSQL
+// schemas. It is the one mapping of the statuses: the service and the
+// backends derive their lookups from it.
+var Statuses = []StatusInfo{
+	{todov1.Status_STATUS_INCOMPLETE, "incomplete", "TODO_STATUS_INCOMPLETE"},
+	{todov1.Status_STATUS_COMPLETED, "completed", "TODO_STATUS_COMPLETED"},
+	{todov1.Status_STATUS_IN_PROGRESS, "in_progress", "TODO_STATUS_IN_PROGRESS"},
+	{todov1.Status_STATUS_BLOCKED, "blocked", "TODO_STATUS_BLOCKED"},
+	{todov1.Status_STATUS_CANCELLED, "cancelled", "TODO_STATUS_CANCELLED"},
+}
+
+// LookupStatus returns the StatusInfo of s, or false if a todo cannot have
+// s, as it cannot have STATUS_UNSPECIFIED.
+func LookupStatus(s todov1.Status) (StatusInfo, bool) {
+	for _, info := range Statuses {
+		if info.Status == s {
+			return info, true
+		}
+	}
+	return StatusInfo{}, false
+}
+
+// StatusValue returns the value stored for s.
+func StatusValue(s todov1.Status) (string, error) {
+	info, ok := LookupStatus(s)
+	if !ok {
+		return "", fmt.Errorf("cannot store status %s", s)
+	}
+	return info.Value, nil
+}
+
+// StatusFromValue returns the status stored as v, or STATUS_UNSPECIFIED if
+// there is none.
+func StatusFromValue(v string) todov1.Status {
+	for _, info := range Statuses {
+		if info.Value == v {
+			return info.Status
+		}
+	}
+	return todov1.Status_STATUS_UNSPECIFIED
+}
@@ -0,0 +1,233 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+// Server serves Connect handlers over h2c, or TLS once UseTLS is called, and
+// shuts down gracefully: when the context passed to Serve is cancelled it stops
+// accepting connections, waits for in-flight calls and streams up to the
+// shutdown timeout, and then runs the registered closers in order. Calls
+// still running at the deadline have their contexts cancelled, and the
+// closers run only once they have returned.
+type Server struct {
+	addr            string
+	handler         http.Handler
+	shutdownTimeout time.Duration
+	// abortGrace is how long requests have to return once their contexts
+	// are cancelled at the shutdown deadline.
+	abortGrace time.Duration
+	logger     *slog.Logger
+	tlsConfig  *tls.Config
+
+	closers []closer
+
+	// abort is cancelled when the shutdown deadline passes, cancelling the
+	// contexts of requests that are still running.
+	abort       context.Context
+	cancelAbort context.CancelFunc
+
+	// mu orders inflight.Add against the switch to draining, so that no
+	// request is added once shutdown has started waiting.
+	mu       sync.Mutex
+	draining bool
+	inflight sync.WaitGroup
+	active   atomic.Int64
+	served   atomic.Int64
+	rejected atomic.Int64
+}
+
+// defaultAbortGrace is the default of Server.abortGrace.
+const defaultAbortGrace = 5 * time.Second
+
+// errorWriter encodes the errors of requests refused while draining in the
+// protocol of the request.
+var errorWriter = connect.NewErrorWriter()
+
+type closer struct {
+	name string
+	fn   func() error
+}
+
+func New(addr string, handler http.Handler, shutdownTimeout time.Duration, logger *slog.Logger) *Server {
+	abort, cancelAbort := context.WithCancel(context.Background())
+	return &Server{
+		addr:            addr,
+		handler:         handler,
+		shutdownTimeout: shutdownTimeout,
+		abortGrace:      defaultAbortGrace,
+		logger:          logger,
+		abort:           abort,
+		cancelAbort:     cancelAbort,
+	}
+}
+
+// RegisterCloser adds a function to run once in-flight requests have drained.
+// Closers run in registration order, so register the database last.
+func (s *Server) RegisterCloser(name string, fn func() error) {
+	s.closers = append(s.closers, closer{name: name, fn: fn})
+}
+
+// UseTLS makes Serve accept TLS connections only. HTTP/2 is negotiated with
+// ALPN, so cfg should list "h2" in NextProtos.
+func (s *Server) UseTLS(cfg *tls.Config) {
+	s.tlsConfig = cfg
+}
+
+// ListenAndServe listens on the configured address and calls Serve.
+func (s *Server) ListenAndServe(ctx context.Context) error {
+	lis, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.addr, err)
+	}
+	return s.Serve(ctx, lis)
+}
+
+// Serve accepts connections on lis until ctx is cancelled, then shuts down.
+// It returns an error if serving fails or in-flight requests did not finish
+// before the shutdown deadline.
+func (s *Server) Serve(ctx context.Context, lis net.Listener) error {
+	httpServer := &http.Server{
+		Handler: h2c.NewHandler(s.track(s.handler), &http2.Server{}),
+	}
+	if s.tlsConfig != nil {
+		// Serve enables HTTP/2 itself for TLS connections that negotiate h2
+		httpServer.Handler = s.track(s.handler)
+		httpServer.TLSConfig = s.tlsConfig
+		lis = tls.NewListener(lis, s.tlsConfig)
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- httpServer.Serve(lis)
+	}()
+
+	select {
+	case err := <-serveErr:
+		// the listener failed before any shutdown was requested
+		s.runClosers()
+		return fmt.Errorf("server failed: %w", err)
+	case <-ctx.Done():
+	}
+
+	start := time.Now()
+	inflightAtSignal := s.active.Load()
+	s.logger.Info("shutdown started", "in_flight", inflightAtSignal, "timeout", s.shutdownTimeout)
+
+	s.mu.Lock()
+	s.draining = true
+	s.mu.Unlock()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+	defer cancel()
+
+	// Shutdown closes the listener and idle HTTP/1 connections. h2c
+	// connections are hijacked and invisible to it, so in-flight calls are
+	// tracked separately.
+	err := httpServer.Shutdown(shutdownCtx)
+	if err == nil {
+		err = s.waitInflight(shutdownCtx)
+	}
+	s.cancelAbort()
+	if errors.Is(err, context.DeadlineExceeded) {
+		err = fmt.Errorf("shutdown deadline exceeded with %d request(s) in flight", s.active.Load())
+
+		// the closers would pull the database from under the requests, so
+		// they run only once the cancelled requests have returned
+		graceCtx, cancelGrace := context.WithTimeout(context.Background(), s.abortGrace)
+		defer cancelGrace()
+		if s.waitInflight(graceCtx) != nil {
+			s.logger.Error("requests did not return after cancellation, skipping closers", "in_flight", s.active.Load())
+			s.logComplete(start, inflightAtSignal, err)
+			return err
+		}
+	}
+
+	s.runClosers()
+	s.logComplete(start, inflightAtSignal, err)
+	return err
+}
+
+// logComplete logs the outcome of a shutdown that started at start.
+func (s *Server) logComplete(start time.Time, inflightAtSignal int64, err error) {
+	s.logger.Info("shutdown complete",
+		"duration", time.Since(start),
+		"in_flight_at_signal", inflightAtSignal,
+		"abandoned", s.active.Load(),
+		"rejected_while_draining", s.rejected.Load(),
+		"served", s.served.Load(),
+		"clean", err == nil,
+	)
+}
+
+// track counts requests so that shutdown can wait for them, and refuses new
+// ones once draining has started.
+func (s *Server) track(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		if s.draining {
+			s.mu.Unlock()
+			s.rejected.Add(1)
+			w.Header().Set("Connection", "close")
+			unavailable := connect.NewError(connect.CodeUnavailable, errors.New("server is shutting down"))
+			if errorWriter.IsSupported(r) {
+				// clients see a retryable Connect or gRPC error
+				errorWriter.Write(w, r, unavailable)
+				return
+			}
+			http.Error(w, unavailable.Message(), http.StatusServiceUnavailable)
+			return
+		}
+		s.inflight.Add(1)
+		s.mu.Unlock()
+		s.active.Add(1)
+		defer func() {
+			s.active.Add(-1)
+			s.served.Add(1)
+			s.inflight.Done()
+		}()
+
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		stop := context.AfterFunc(s.abort, cancel)
+		defer stop()
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func (s *Server) waitInflight(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.inflight.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Server) runClosers() {
+	for _, c := range s.closers {
+		if err := c.fn(); err != nil {
+			s.logger.Error("failed to close", "name", c.name, "error", err)
+			continue
+		}
+		s.logger.Info("closed", "name", c.name)
+	}
+}
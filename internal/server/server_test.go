@@ -0,0 +1,273 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/net/http2"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// h2cClient speaks HTTP/2 without TLS, like the Connect gRPC clients do.
+func h2cClient() *http.Client {
+	return &http.Client{
+		Transport: &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, addr)
+			},
+		},
+	}
+}
+
+func startServer(t *testing.T, ctx context.Context, handler http.Handler, timeout time.Duration, closed func()) (string, <-chan error) {
+	t.Helper()
+	return startTestServer(t, ctx, New("", handler, timeout, slog.New(slog.NewTextHandler(io.Discard, nil))), closed)
+}
+
+func startTestServer(t *testing.T, ctx context.Context, srv *Server, closed func()) (string, <-chan error) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.RegisterCloser("test", func() error {
+		closed()
+		return nil
+	})
+
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.Serve(ctx, lis)
+	}()
+	return "http://" + lis.Addr().String(), done
+}
+
+func TestServeDrainsInFlightRequestsOnSIGTERM(t *testing.T) {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM)
+	defer stop()
+
+	var (
+		mu     sync.Mutex
+		events []string
+	)
+	record := func(e string) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, e)
+	}
+
+	started := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		record("handler finished")
+		io.WriteString(w, "ok")
+	})
+	url, done := startServer(t, ctx, handler, 5*time.Second, func() { record("closed") })
+
+	type result struct {
+		body string
+		err  error
+	}
+	resCh := make(chan result, 1)
+	go func() {
+		res, err := h2cClient().Get(url)
+		if err != nil {
+			resCh <- result{err: err}
+			return
+		}
+		defer res.Body.Close()
+		b, err := io.ReadAll(res.Body)
+		resCh <- result{body: string(b), err: err}
+	}()
+
+	<-started
+	if err := syscall.Kill(os.Getpid(), syscall.SIGTERM); err != nil {
+		t.Fatal(err)
+	}
+
+	res := <-resCh
+	if res.err != nil {
+		t.Fatalf("in-flight request failed: %v", res.err)
+	}
+	if res.body != "ok" {
+		t.Fatalf("body = %q, want %q", res.body, "ok")
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Serve returned %v, want nil", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve did not return after SIGTERM")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(events) != 2 || events[0] != "handler finished" || events[1] != "closed" {
+		t.Fatalf("events = %v, want the handler to finish before closers run", events)
+	}
+}
+
+func TestServeAbortsRequestsAfterDeadline(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	started := make(chan struct{})
+	aborted := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		select {
+		case <-r.Context().Done():
+			// like a query that still has to notice the cancellation
+			time.Sleep(100 * time.Millisecond)
+			close(aborted)
+		case <-time.After(10 * time.Second):
+		}
+	})
+	closed := make(chan struct{})
+	url, done := startServer(t, ctx, handler, 100*time.Millisecond, func() {
+		select {
+		case <-aborted:
+		default:
+			t.Error("closers ran before the aborted request returned")
+		}
+		close(closed)
+	})
+
+	go func() {
+		res, err := h2cClient().Get(url)
+		if err == nil {
+			res.Body.Close()
+		}
+	}()
+
+	<-started
+	cancel()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("Serve returned nil, want a deadline error")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve did not return after the shutdown deadline")
+	}
+
+	select {
+	case <-aborted:
+	case <-time.After(time.Second):
+		t.Fatal("request context was not cancelled after the deadline")
+	}
+	select {
+	case <-closed:
+	default:
+		t.Fatal("closers did not run")
+	}
+}
+
+func TestServeSkipsClosersWhileRequestsRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		// ignores the cancellation of its context
+		<-release
+	})
+	srv := New("", handler, 50*time.Millisecond, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	srv.abortGrace = 50 * time.Millisecond
+	url, done := startTestServer(t, ctx, srv, func() { t.Error("closers ran while a request was running") })
+
+	go func() {
+		res, err := h2cClient().Get(url)
+		if err == nil {
+			res.Body.Close()
+		}
+	}()
+
+	<-started
+	cancel()
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("Serve returned nil, want a deadline error")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve did not return after the shutdown deadline")
+	}
+}
+
+func TestServeRejectsCallsWhileDraining(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const procedure = "/test.v1.Service/Ping"
+	started := make(chan struct{})
+	release := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	})
+	mux.Handle(procedure, connect.NewUnaryHandler(procedure, func(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+		return connect.NewResponse(&emptypb.Empty{}), nil
+	}))
+	url, done := startServer(t, ctx, mux, 5*time.Second, func() {})
+
+	// the calls share the connection of the slow request, which stays open
+	// while the server drains
+	client := h2cClient()
+	go func() {
+		res, err := client.Get(url + "/slow")
+		if err == nil {
+			res.Body.Close()
+		}
+	}()
+	<-started
+	ping := connect.NewClient[emptypb.Empty, emptypb.Empty](client, url+procedure)
+	if _, err := ping.CallUnary(context.Background(), connect.NewRequest(&emptypb.Empty{})); err != nil {
+		t.Fatalf("call before shutdown: %v", err)
+	}
+
+	cancel()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		_, err := ping.CallUnary(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) && connectErr.Code() == connect.CodeUnavailable {
+			// a refused connection would be unavailable too
+			if connectErr.Message() != "server is shutting down" {
+				t.Fatalf("call while draining = %v, want the server to refuse it", err)
+			}
+			break
+		}
+		if err != nil || time.Now().After(deadline) {
+			t.Fatalf("call while draining = %v, want code unavailable", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("Serve returned %v, want nil", err)
+	}
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/kogamitora/todo/internal/apierr"
+	"github.com/kogamitora/todo/internal/logging"
+	"github.com/kogamitora/todo/internal/repository"
+	"github.com/kogamitora/todo/internal/storage"
+)
+
+// uploadBufferSize is the size of the reads Upload spools.
+const uploadBufferSize = 64 * 1024
+
+// AttachmentService
+type AttachmentService struct {
+	attachments repository.AttachmentRepository
+	todos       repository.TodoRepository
+	store       storage.BlobStore
+	maxSize     int64
+	logger      *slog.Logger
+}
+
+func NewAttachmentService(attachments repository.AttachmentRepository, todos repository.TodoRepository, store storage.BlobStore, maxSize int64, logger *slog.Logger) *AttachmentService {
+	return &AttachmentService{
+		attachments: attachments,
+		todos:       todos,
+		store:       store,
+		maxSize:     maxSize,
+		logger:      logger,
+	}
+}
+
+// log returns the request-scoped logger set by the logging interceptor.
+func (s *AttachmentService) log(ctx context.Context) *slog.Logger {
+	return logging.FromContext(ctx, s.logger)
+}
+
+// blobKey is the storage key of the contents with the given hash.
+func blobKey(sum string) string {
+	return "sha256/" + sum[:2] + "/" + sum
+}
+
+// UploadParams describe a new attachment.
+type UploadParams struct {
+	TodoID int64
+	// Filename may be a path; only its last element is kept.
+	Filename    string
+	ContentType string // application/octet-stream if empty
+}
+
+// Upload attaches the contents read from r to a todo. Identical contents are
+// stored only once. Errors from r are returned as they are, so r should
+// return Connect errors.
+func (s *AttachmentService) Upload(ctx context.Context, p UploadParams, r io.Reader) (*repository.Attachment, error) {
+	filename := path.Base(strings.ReplaceAll(p.Filename, "\\", "/"))
+	if filename == "" || filename == "." || filename == "/" {
+		return nil, apierr.InvalidField("info.filename", "filename is required")
+	}
+	contentType := p.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	if err := checkTodoExists(ctx, s.todos, s.log(ctx), p.TodoID); err != nil {
+		return nil, err
+	}
+
+	// spool to a temporary file while hashing, so the blob key is known
+	// before anything reaches the store
+	tmp, err := os.CreateTemp("", "todo-upload-*")
+	if err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to create temp file", "error", err)
+		return nil, apierr.Convert(err)
+	}
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+
+	hash := sha256.New()
+	w := io.MultiWriter(tmp, hash)
+	var size int64
+	buf := make([]byte, uploadBufferSize)
+	for {
+		n, readErr := r.Read(buf)
+		size += int64(n)
+		if size > s.maxSize {
+			return nil, connect.NewError(connect.CodeResourceExhausted,
+				fmt.Errorf("attachment exceeds the maximum size of %d bytes", s.maxSize))
+		}
+		if _, err := w.Write(buf[:n]); err != nil {
+			s.log(ctx).ErrorContext(ctx, "failed to spool upload", "error", err)
+			return nil, apierr.Convert(err)
+		}
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			return nil, readErr
+		}
+	}
+	sum := hex.EncodeToString(hash.Sum(nil))
+
+	key := blobKey(sum)
+	exists, err := s.store.Exists(ctx, key)
+	if err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to check blob", "key", key, "error", err)
+		return nil, apierr.Convert(err)
+	}
+	if !exists {
+		if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+			s.log(ctx).ErrorContext(ctx, "failed to rewind upload", "error", err)
+			return nil, apierr.Convert(err)
+		}
+		if err := s.store.Put(ctx, key, tmp, size); err != nil {
+			s.log(ctx).ErrorContext(ctx, "failed to store blob", "key", key, "error", err)
+			return nil, apierr.Convert(err)
+		}
+	}
+
+	attachment := &repository.Attachment{
+		TodoID:      p.TodoID,
+		Filename:    filename,
+		ContentType: contentType,
+		Size:        size,
+		Sha256:      sum,
+	}
+	if err := s.attachments.Create(ctx, attachment); err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to insert attachment", "error", err)
+		return nil, apierr.Convert(err)
+	}
+	return attachment, nil
+}
+
+// Open returns an attachment and its contents, which the caller must close.
+// Reading the contents returns Connect errors.
+func (s *AttachmentService) Open(ctx context.Context, id int64) (*repository.Attachment, io.ReadCloser, error) {
+	attachment, err := s.attachments.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("attachment with id %d not found", id))
+		}
+		s.log(ctx).ErrorContext(ctx, "failed to find attachment", "id", id, "error", err)
+		return nil, nil, apierr.Convert(err)
+	}
+
+	blob, err := s.store.Get(ctx, blobKey(attachment.Sha256))
+	if err != nil {
+		// the row exists, so a missing blob means the store lost data
+		s.log(ctx).ErrorContext(ctx, "failed to open blob", "id", attachment.ID, "sha256", attachment.Sha256, "error", err)
+		return nil, nil, apierr.Convert(err)
+	}
+	return attachment, &blobReader{ReadCloser: blob, ctx: ctx, logger: s.log(ctx), id: attachment.ID}, nil
+}
+
+// blobReader logs and converts the errors of reading an attachment.
+type blobReader struct {
+	io.ReadCloser
+	ctx    context.Context
+	logger *slog.Logger
+	id     int64
+}
+
+func (r *blobReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		r.logger.ErrorContext(r.ctx, "failed to read blob", "id", r.id, "error", err)
+		return n, apierr.Convert(err)
+	}
+	return n, err
+}
+
+// List returns the attachments of a todo.
+func (s *AttachmentService) List(ctx context.Context, todoID int64) ([]*repository.Attachment, error) {
+	if err := checkTodoExists(ctx, s.todos, s.log(ctx), todoID); err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.attachments.List(ctx, todoID)
+	if err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to list attachments", "error", err)
+		return nil, apierr.Convert(err)
+	}
+	return attachments, nil
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/kogamitora/todo/internal/apierr"
+	"github.com/kogamitora/todo/internal/logging"
+	"github.com/kogamitora/todo/internal/repository"
+)
+
+// CommentService
+type CommentService struct {
+	comments repository.CommentRepository
+	todos    repository.TodoRepository
+	logger   *slog.Logger
+}
+
+func NewCommentService(comments repository.CommentRepository, todos repository.TodoRepository, logger *slog.Logger) *CommentService {
+	return &CommentService{
+		comments: comments,
+		todos:    todos,
+		logger:   logger,
+	}
+}
+
+// log returns the request-scoped logger set by the logging interceptor.
+func (s *CommentService) log(ctx context.Context) *slog.Logger {
+	return logging.FromContext(ctx, s.logger)
+}
+
+// find finds a comment by its ID and handles common errors.
+func (s *CommentService) find(ctx context.Context, id int64) (*repository.Comment, error) {
+	comment, err := s.comments.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("comment with id %d not found", id))
+		}
+		s.log(ctx).ErrorContext(ctx, "failed to find comment", "id", id, "error", err)
+		return nil, apierr.Convert(err)
+	}
+	return comment, nil
+}
+
+// checkUser returns CodeUnauthenticated if the request has no user.
+func checkUser(user string) error {
+	if strings.TrimSpace(user) == "" {
+		return connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("comments need a user: set the %s header", logging.UserHeader))
+	}
+	return nil
+}
+
+// findOwn finds a comment that caller may change: only its author may.
+func (s *CommentService) findOwn(ctx context.Context, id int64, caller string) (*repository.Comment, error) {
+	if err := checkUser(caller); err != nil {
+		return nil, err
+	}
+	comment, err := s.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.Author != caller {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("comment %d is by another user", id))
+	}
+	return comment, nil
+}
+
+// Add comments on a todo as author, the user making the request, never a
+// name the client picks.
+func (s *CommentService) Add(ctx context.Context, todoID int64, author, body string) (*repository.Comment, error) {
+	if strings.TrimSpace(body) == "" {
+		return nil, apierr.InvalidField("body", "comment body is required")
+	}
+	if err := checkUser(author); err != nil {
+		return nil, err
+	}
+	if err := checkTodoExists(ctx, s.todos, s.log(ctx), todoID); err != nil {
+		return nil, err
+	}
+
+	comment := &repository.Comment{
+		TodoID: todoID,
+		Author: author,
+		Body:   body,
+	}
+	if err := s.comments.Create(ctx, comment); err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to insert comment", "error", err)
+		return nil, apierr.Convert(err)
+	}
+	return comment, nil
+}
+
+// Update replaces the body of a comment by caller.
+func (s *CommentService) Update(ctx context.Context, id int64, caller, body string) (*repository.Comment, error) {
+	if strings.TrimSpace(body) == "" {
+		return nil, apierr.InvalidField("body", "comment body is required")
+	}
+
+	comment, err := s.findOwn(ctx, id, caller)
+	if err != nil {
+		return nil, err
+	}
+
+	comment.Body = body
+	if err := s.comments.Update(ctx, comment); err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to update comment", "error", err)
+		return nil, apierr.Convert(err)
+	}
+	return comment, nil
+}
+
+// Delete soft-deletes a comment by caller: it is kept with DeletedAt set.
+func (s *CommentService) Delete(ctx context.Context, id int64, caller string) error {
+	comment, err := s.findOwn(ctx, id, caller)
+	if err != nil {
+		return err
+	}
+	if err := s.comments.Delete(ctx, comment.ID); err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to soft delete comment", "error", err)
+		return apierr.Convert(err)
+	}
+	return nil
+}
+
+// List returns the comments on a todo, oldest first. A limit keeps only
+// the latest limit comments; nil keeps them all.
+func (s *CommentService) List(ctx context.Context, todoID int64, limit *int) ([]*repository.Comment, error) {
+	n := 0
+	if limit != nil {
+		if *limit <= 0 {
+			return nil, apierr.InvalidField("limit", "limit must be positive")
+		}
+		n = *limit
+	}
+	if err := checkTodoExists(ctx, s.todos, s.log(ctx), todoID); err != nil {
+		return nil, err
+	}
+
+	comments, err := s.comments.List(ctx, todoID, n)
+	if err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to list comments", "error", err)
+		return nil, apierr.Convert(err)
+	}
+	return comments, nil
+}
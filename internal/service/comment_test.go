@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"github.com/kogamitora/todo/internal/repository/memory"
+)
+
+func TestComments(t *testing.T) {
+	ctx := context.Background()
+	repos := memory.NewRepositories()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	todos := NewTodoService(repos.Todos, repos.Dependencies, logger, DefaultTransitions(), 0)
+	s := NewCommentService(repos.Comments, repos.Todos, logger)
+	todo := mustCreate(t, todos, "a")
+
+	_, err := s.Add(ctx, todo.ID, "alice", " ")
+	wantCode(t, err, connect.CodeInvalidArgument)
+	_, err = s.Add(ctx, todo.ID, "", "hello")
+	wantCode(t, err, connect.CodeUnauthenticated)
+	_, err = s.Add(ctx, todo.ID+1, "alice", "hello")
+	wantCode(t, err, connect.CodeNotFound)
+
+	c, err := s.Add(ctx, todo.ID, "alice", "hello")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Author != "alice" || c.TodoID != todo.ID {
+		t.Errorf("comment = %+v", c)
+	}
+	if _, err := s.Update(ctx, c.ID, "alice", "edited"); err != nil {
+		t.Fatal(err)
+	}
+	zero := 0
+	_, err = s.List(ctx, todo.ID, &zero)
+	wantCode(t, err, connect.CodeInvalidArgument)
+	list, err := s.List(ctx, todo.ID, nil)
+	if err != nil || len(list) != 1 || list[0].Body != "edited" {
+		t.Fatalf("List = %v, %v", list, err)
+	}
+
+	// the comments of a deleted todo are hidden with it
+	if err := todos.Delete(ctx, todo.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.List(ctx, todo.ID, nil)
+	wantCode(t, err, connect.CodeNotFound)
+
+	if err := s.Delete(ctx, c.ID, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	err = s.Delete(ctx, c.ID, "alice")
+	wantCode(t, err, connect.CodeNotFound)
+}
+
+func TestCommentsChangedByAuthorOnly(t *testing.T) {
+	ctx := context.Background()
+	repos := memory.NewRepositories()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	todos := NewTodoService(repos.Todos, repos.Dependencies, logger, DefaultTransitions(), 0)
+	s := NewCommentService(repos.Comments, repos.Todos, logger)
+	todo := mustCreate(t, todos, "a")
+	c, err := s.Add(ctx, todo.ID, "alice", "hello")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = s.Update(ctx, c.ID, "mallory", "rewritten")
+	wantCode(t, err, connect.CodePermissionDenied)
+	_, err = s.Update(ctx, c.ID, "", "rewritten")
+	wantCode(t, err, connect.CodeUnauthenticated)
+	err = s.Delete(ctx, c.ID, "mallory")
+	wantCode(t, err, connect.CodePermissionDenied)
+	err = s.Delete(ctx, c.ID, "")
+	wantCode(t, err, connect.CodeUnauthenticated)
+
+	list, err := s.List(ctx, todo.ID, nil)
+	if err != nil || len(list) != 1 || list[0].Body != "hello" {
+		t.Fatalf("comments after refused changes = %v, %v", list, err)
+	}
+	if _, err := s.Update(ctx, c.ID, "alice", "edited"); err != nil {
+		t.Errorf("Update by the author: %v", err)
+	}
+	if err := s.Delete(ctx, c.ID, "alice"); err != nil {
+		t.Errorf("Delete by the author: %v", err)
+	}
+}
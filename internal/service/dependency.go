@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	"github.com/kogamitora/todo/internal/apierr"
+	"github.com/kogamitora/todo/internal/repository"
+)
+
+// attachBlockers adds the non-deleted blockers to the given todos.
+func (s *TodoService) attachBlockers(ctx context.Context, todos ...*repository.Todo) ([]*Todo, error) {
+	list := make([]*Todo, len(todos))
+	if len(todos) == 0 {
+		return list, nil
+	}
+	ids := make([]int64, len(todos))
+	byID := make(map[int64]*Todo, len(todos))
+	for i, t := range todos {
+		list[i] = &Todo{Todo: *t}
+		ids[i] = t.ID
+		byID[t.ID] = list[i]
+	}
+
+	deps, err := s.deps.ActiveBlockers(ctx, ids...)
+	if err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to load dependencies", "error", err)
+		return nil, apierr.Convert(err)
+	}
+	for _, d := range deps {
+		t := byID[d.TodoID]
+		t.BlockedBy = append(t.BlockedBy, d.BlockedByID)
+	}
+	return list, nil
+}
+
+// withBlockers is attachBlockers for a single todo.
+func (s *TodoService) withBlockers(ctx context.Context, todo *repository.Todo) (*Todo, error) {
+	list, err := s.attachBlockers(ctx, todo)
+	if err != nil {
+		return nil, err
+	}
+	return list[0], nil
+}
+
+// AddDependency records that todoID is blocked by blockedByID, which must
+// not close a cycle.
+func (s *TodoService) AddDependency(ctx context.Context, todoID, blockedByID int64) (*Todo, error) {
+	if todoID == blockedByID {
+		return nil, apierr.InvalidField("blocked_by_id", "a todo cannot depend on itself")
+	}
+
+	todo, err := s.find(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.find(ctx, blockedByID); err != nil {
+		return nil, err
+	}
+
+	// the repository checks for a cycle in the same transaction as the insert
+	err = s.deps.Add(ctx, repository.Dependency{TodoID: todoID, BlockedByID: blockedByID})
+	if errors.Is(err, repository.ErrAlreadyExists) {
+		return nil, connect.NewError(connect.CodeAlreadyExists,
+			fmt.Errorf("todo %d is already blocked by todo %d", todoID, blockedByID))
+	}
+	if errors.Is(err, repository.ErrCycle) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to insert dependency", "error", err)
+		return nil, apierr.Convert(err)
+	}
+	return s.withBlockers(ctx, todo)
+}
+
+// RemoveDependency removes the dependency of todoID on blockedByID.
+func (s *TodoService) RemoveDependency(ctx context.Context, todoID, blockedByID int64) (*Todo, error) {
+	todo, err := s.find(ctx, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.deps.Remove(ctx, repository.Dependency{TodoID: todoID, BlockedByID: blockedByID})
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound,
+			fmt.Errorf("todo %d is not blocked by todo %d", todoID, blockedByID))
+	}
+	if err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to delete dependency", "error", err)
+		return nil, apierr.Convert(err)
+	}
+	return s.withBlockers(ctx, todo)
+}
@@ -0,0 +1,352 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/internal/apierr"
+	"github.com/kogamitora/todo/internal/repository"
+)
+
+// positionDigits are the digits of the fractional position keys, in ASCII
+// order so that keys compare correctly as binary strings.
+const positionDigits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// A position key is an integer followed by a fraction. The first character
+// of the integer gives its length: "a" to "z" start integers of 2 to 27
+// characters counting up from "a0", "A" to "Z" integers of 27 to 2
+// characters counting down from "Zz". Appending or prepending increments or
+// decrements the integer, so keys grow with the logarithm of the number of
+// todos. Inserting between two keys lengthens the fraction instead, which
+// can never end in the zero digit, so that a key between any two exists.
+const (
+	// firstPosition is the key of the first todo in an empty column.
+	firstPosition = "a0"
+	// smallestInteger is reserved so that a key before every other exists.
+	smallestInteger = "A00000000000000000000000000"
+	// maxPositionLength is the longest key positionBetween returns, well
+	// within the 255 bytes of the position columns.
+	maxPositionLength = 64
+)
+
+var (
+	// errPositionOrder is returned by positionBetween when a is not
+	// before b.
+	errPositionOrder = errors.New("position keys out of order")
+	// errRebalance is returned by positionBetween when a or b is not a key
+	// of the current format, such as the keys migration 000004 gave
+	// existing todos, or when the key between them would be longer than
+	// maxPositionLength. Renumbering the column fixes both.
+	errRebalance = errors.New("position keys need rebalancing")
+)
+
+// positionBetween returns a key that sorts strictly between a and b.
+// An empty a means "before everything" and an empty b "after everything".
+func positionBetween(a, b string) (string, error) {
+	if a != "" && b != "" && a >= b {
+		return "", fmt.Errorf("%w: %q..%q", errPositionOrder, a, b)
+	}
+	key, err := keyBetween(a, b)
+	if err != nil {
+		return "", err
+	}
+	if len(key) > maxPositionLength {
+		return "", errRebalance
+	}
+	return key, nil
+}
+
+func keyBetween(a, b string) (string, error) {
+	intA, fracA, err := splitPosition(a)
+	if err != nil {
+		return "", err
+	}
+	intB, fracB, err := splitPosition(b)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case a == "" && b == "":
+		return firstPosition, nil
+	case a == "":
+		if intB == smallestInteger {
+			return intB + midpoint("", fracB), nil
+		}
+		if intB < b {
+			// b has a fraction, so its integer alone comes first
+			return intB, nil
+		}
+		// intB is not the smallest integer, so it has a predecessor
+		prev, _ := decrementInteger(intB)
+		if prev == smallestInteger {
+			return prev + midpoint("", ""), nil
+		}
+		return prev, nil
+	case b == "":
+		if next, ok := incrementInteger(intA); ok {
+			return next, nil
+		}
+		return intA + midpoint(fracA, ""), nil
+	case intA == intB:
+		return intA + midpoint(fracA, fracB), nil
+	}
+	if next, ok := incrementInteger(intA); ok && next < b {
+		return next, nil
+	}
+	return intA + midpoint(fracA, ""), nil
+}
+
+// splitPosition splits a key into its integer and its fraction, or returns
+// errRebalance if it is not a valid key. The empty key splits into two
+// empty strings.
+func splitPosition(key string) (integer, fraction string, err error) {
+	if key == "" {
+		return "", "", nil
+	}
+	n := integerLength(key[0])
+	if n == 0 || n > len(key) || strings.Trim(key[1:], positionDigits) != "" {
+		return "", "", errRebalance
+	}
+	integer, fraction = key[:n], key[n:]
+	if integer == smallestInteger && fraction == "" || strings.HasSuffix(fraction, positionDigits[:1]) {
+		return "", "", errRebalance
+	}
+	return integer, fraction, nil
+}
+
+// integerLength returns the length of the integer that starts with head,
+// or zero if no integer does.
+func integerLength(head byte) int {
+	switch {
+	case head >= 'a' && head <= 'z':
+		return int(head-'a') + 2
+	case head >= 'A' && head <= 'Z':
+		return int('Z'-head) + 2
+	}
+	return 0
+}
+
+// incrementInteger returns the integer after x, or false if x is the
+// largest one.
+func incrementInteger(x string) (string, bool) {
+	head, digits := x[0], []byte(x[1:])
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := strings.IndexByte(positionDigits, digits[i]) + 1
+		if d < len(positionDigits) {
+			digits[i] = positionDigits[d]
+			return string(head) + string(digits), true
+		}
+		digits[i] = positionDigits[0]
+	}
+	// every digit carried over: the next integer is one digit longer, or
+	// one shorter among the negative ones
+	switch {
+	case head == 'Z':
+		return "a" + positionDigits[:1], true
+	case head == 'z':
+		return "", false
+	case head >= 'a':
+		return string(head+1) + string(digits) + positionDigits[:1], true
+	}
+	return string(head+1) + string(digits[1:]), true
+}
+
+// decrementInteger returns the integer before x, or false if x is the
+// smallest one.
+func decrementInteger(x string) (string, bool) {
+	head, digits := x[0], []byte(x[1:])
+	last := positionDigits[len(positionDigits)-1]
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := strings.IndexByte(positionDigits, digits[i]) - 1
+		if d >= 0 {
+			digits[i] = positionDigits[d]
+			return string(head) + string(digits), true
+		}
+		digits[i] = last
+	}
+	switch {
+	case head == 'a':
+		return "Z" + string(last), true
+	case head == 'A':
+		return "", false
+	case head > 'a':
+		return string(head-1) + string(digits[1:]), true
+	}
+	return string(head-1) + string(digits) + string(last), true
+}
+
+// midpoint returns a fraction between the fractions a and b, where an
+// empty b means "after everything".
+func midpoint(a, b string) string {
+	if b != "" {
+		// Keep the common prefix and recurse on the remainder.
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			return b[:n] + midpoint(safeSuffix(a, n), b[n:])
+		}
+	}
+
+	lo := 0
+	if a != "" {
+		lo = strings.IndexByte(positionDigits, a[0])
+	}
+	hi := len(positionDigits)
+	if b != "" {
+		hi = strings.IndexByte(positionDigits, b[0])
+	}
+	if hi-lo > 1 {
+		return string(positionDigits[(lo+hi+1)/2])
+	}
+	// The first digits are adjacent: take a's digit and go one level deeper.
+	if b != "" && len(b) > 1 {
+		return b[:1]
+	}
+	return string(positionDigits[lo]) + midpoint(safeSuffix(a, 1), "")
+}
+
+func digitAt(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+	return positionDigits[0]
+}
+
+func safeSuffix(s string, n int) string {
+	if n < len(s) {
+		return s[n:]
+	}
+	return ""
+}
+
+// positionKeys returns n increasing keys that are as short as they can be.
+func positionKeys(n int) []string {
+	keys := make([]string, n)
+	key := firstPosition
+	for i := range keys {
+		keys[i] = key
+		// there are more keys than any column can hold
+		key, _ = incrementInteger(key)
+	}
+	return keys
+}
+
+// rebalance gives the todos of a status column new, short keys in the same
+// order.
+func (s *TodoService) rebalance(ctx context.Context, status todov1.Status) error {
+	s.log(ctx).InfoContext(ctx, "renumbering positions", "status", statusName(status))
+	return s.todos.RenumberPositions(ctx, status, positionKeys)
+}
+
+// lastPosition returns a key after every todo in the given status column.
+func (s *TodoService) lastPosition(ctx context.Context, status todov1.Status) (string, error) {
+	var position string
+	err := s.withRebalance(ctx, status, func() error {
+		after, err := s.todos.LastPosition(ctx, status)
+		if err != nil {
+			return err
+		}
+		position, err = positionBetween(after, "")
+		return err
+	})
+	return position, err
+}
+
+// withRebalance calls place, which computes a position, and, if it fails
+// with errRebalance, renumbers the column and calls place once more.
+func (s *TodoService) withRebalance(ctx context.Context, status todov1.Status, place func() error) error {
+	err := place()
+	if !errors.Is(err, errRebalance) {
+		return err
+	}
+	if err := s.rebalance(ctx, status); err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to renumber positions", "status", statusName(status), "error", err)
+		return err
+	}
+	return place()
+}
+
+// Move places a todo right before the todo beforeID and/or right after the
+// todo afterID, which must be in the same status column. At least one of
+// them is required.
+func (s *TodoService) Move(ctx context.Context, id int64, beforeID, afterID *int64) (*Todo, error) {
+	if beforeID == nil && afterID == nil {
+		return nil, apierr.InvalidField("before_id", "before_id or after_id is required")
+	}
+
+	todo, err := s.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// loadNeighbor fetches a neighbor and checks that it shares the todo's column.
+	loadNeighbor := func(field string, id int64) (*repository.Todo, error) {
+		if id == todo.ID {
+			return nil, apierr.InvalidField(field, "a todo cannot be moved relative to itself")
+		}
+		n, err := s.find(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if n.Status != todo.Status {
+			return nil, apierr.Invalidf(field, "todo %d is in a different status column", id)
+		}
+		return n, nil
+	}
+
+	// place reads the neighbors' positions, which a rebalance changes
+	var position string
+	place := func() error {
+		var lower, upper string
+		if beforeID != nil {
+			before, err := loadNeighbor("before_id", *beforeID)
+			if err != nil {
+				return err
+			}
+			upper = before.Position
+			if afterID == nil {
+				if lower, err = s.todos.NeighborPosition(ctx, before.Status, before.Position, todo.ID, true); err != nil {
+					s.log(ctx).ErrorContext(ctx, "failed to find neighbor", "id", before.ID, "error", err)
+					return apierr.Convert(err)
+				}
+			}
+		}
+		if afterID != nil {
+			after, err := loadNeighbor("after_id", *afterID)
+			if err != nil {
+				return err
+			}
+			lower = after.Position
+			if beforeID == nil {
+				if upper, err = s.todos.NeighborPosition(ctx, after.Status, after.Position, todo.ID, false); err != nil {
+					s.log(ctx).ErrorContext(ctx, "failed to find neighbor", "id", after.ID, "error", err)
+					return apierr.Convert(err)
+				}
+			}
+		}
+
+		var err error
+		position, err = positionBetween(lower, upper)
+		if errors.Is(err, errPositionOrder) {
+			return apierr.InvalidField("after_id", "after_id must come before before_id in the current order")
+		}
+		return err
+	}
+	if err := s.withRebalance(ctx, todo.Status, place); err != nil {
+		return nil, apierr.Convert(err)
+	}
+
+	// the todo itself may have been renumbered, which Update overwrites
+	todo.Position = position
+	if err := s.todos.Update(ctx, todo); err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to move todo", "error", err)
+		return nil, apierr.Convert(err)
+	}
+	return s.withBlockers(ctx, todo)
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/internal/repository"
+)
+
+// validStatus reports whether s is a status a todo can have, which
+// STATUS_UNSPECIFIED is not.
+func validStatus(s todov1.Status) bool {
+	_, ok := repository.LookupStatus(s)
+	return ok
+}
+
+func statusFromName(name string) (todov1.Status, bool) {
+	for _, info := range repository.Statuses {
+		if info.Name == name {
+			return info.Status, true
+		}
+	}
+	return todov1.Status_STATUS_UNSPECIFIED, false
+}
+
+func statusName(s todov1.Status) string {
+	if info, ok := repository.LookupStatus(s); ok {
+		return info.Name
+	}
+	return s.String()
+}
+
+// TransitionGraph lists, for each status, the statuses a todo may move to.
+// Staying in the same status is always allowed.
+type TransitionGraph map[todov1.Status][]todov1.Status
+
+// DefaultTransitions returns the workflow used when none is configured.
+func DefaultTransitions() TransitionGraph {
+	return TransitionGraph{
+		todov1.Status_STATUS_INCOMPLETE: {
+			todov1.Status_STATUS_IN_PROGRESS,
+			todov1.Status_STATUS_BLOCKED,
+			todov1.Status_STATUS_COMPLETED,
+			todov1.Status_STATUS_CANCELLED,
+		},
+		todov1.Status_STATUS_IN_PROGRESS: {
+			todov1.Status_STATUS_INCOMPLETE,
+			todov1.Status_STATUS_BLOCKED,
+			todov1.Status_STATUS_COMPLETED,
+			todov1.Status_STATUS_CANCELLED,
+		},
+		todov1.Status_STATUS_BLOCKED: {
+			todov1.Status_STATUS_INCOMPLETE,
+			todov1.Status_STATUS_IN_PROGRESS,
+			todov1.Status_STATUS_CANCELLED,
+		},
+		todov1.Status_STATUS_COMPLETED: {
+			todov1.Status_STATUS_INCOMPLETE,
+		},
+		todov1.Status_STATUS_CANCELLED: {
+			todov1.Status_STATUS_INCOMPLETE,
+		},
+	}
+}
+
+// ParseTransitions parses a workflow definition such as
+//
+//	incomplete:in_progress|completed;in_progress:completed|incomplete
+//
+// An empty spec returns DefaultTransitions.
+func ParseTransitions(spec string) (TransitionGraph, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return DefaultTransitions(), nil
+	}
+
+	graph := TransitionGraph{}
+	for _, rule := range strings.Split(spec, ";") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		from, targets, ok := strings.Cut(rule, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid transition rule %q: expected from:to|to", rule)
+		}
+		fromStatus, ok := statusFromName(strings.TrimSpace(from))
+		if !ok {
+			return nil, fmt.Errorf("unknown status %q in transition rule %q", from, rule)
+		}
+		for _, to := range strings.Split(targets, "|") {
+			toStatus, ok := statusFromName(strings.TrimSpace(to))
+			if !ok {
+				return nil, fmt.Errorf("unknown status %q in transition rule %q", to, rule)
+			}
+			graph[fromStatus] = append(graph[fromStatus], toStatus)
+		}
+	}
+	return graph, nil
+}
+
+// Allows reports whether a todo may move from one status to another.
+func (g TransitionGraph) Allows(from, to todov1.Status) bool {
+	if from == to {
+		return true
+	}
+	for _, s := range g[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/internal/repository"
+)
+
+func TestParseTransitions(t *testing.T) {
+	const (
+		incomplete = todov1.Status_STATUS_INCOMPLETE
+		inProgress = todov1.Status_STATUS_IN_PROGRESS
+		completed  = todov1.Status_STATUS_COMPLETED
+	)
+	tests := []struct {
+		name    string
+		spec    string
+		want    TransitionGraph
+		wantErr string
+	}{
+		{name: "empty", spec: "", want: DefaultTransitions()},
+		{name: "blank", spec: "  ", want: DefaultTransitions()},
+		{
+			name: "rules",
+			spec: "incomplete:in_progress|completed; in_progress : completed ;",
+			want: TransitionGraph{
+				incomplete: {inProgress, completed},
+				inProgress: {completed},
+			},
+		},
+		{
+			name: "repeated source",
+			spec: "incomplete:in_progress;incomplete:completed",
+			want: TransitionGraph{incomplete: {inProgress, completed}},
+		},
+		{name: "missing colon", spec: "incomplete-in_progress", wantErr: "expected from:to|to"},
+		{name: "no targets", spec: "incomplete:", wantErr: `unknown status ""`},
+		{name: "empty target", spec: "incomplete:in_progress||completed", wantErr: `unknown status ""`},
+		{name: "unknown source", spec: "done:incomplete", wantErr: `unknown status "done"`},
+		{name: "unknown target", spec: "incomplete:done", wantErr: `unknown status "done"`},
+		{name: "CLI spelling", spec: "incomplete:in-progress", wantErr: `unknown status "in-progress"`},
+		{name: "enum name", spec: "STATUS_INCOMPLETE:completed", wantErr: `unknown status "STATUS_INCOMPLETE"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTransitions(tt.spec)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want one containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("graph = %v, want %v", got, tt.want)
+			}
+			for from, to := range tt.want {
+				if !slices.Equal(got[from], to) {
+					t.Errorf("%s -> %v, want %v", from, got[from], to)
+				}
+			}
+		})
+	}
+}
+
+func TestTransitionGraphAllows(t *testing.T) {
+	graph, err := ParseTransitions("incomplete:in_progress;in_progress:completed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		from, to todov1.Status
+		want     bool
+	}{
+		{todov1.Status_STATUS_INCOMPLETE, todov1.Status_STATUS_IN_PROGRESS, true},
+		{todov1.Status_STATUS_IN_PROGRESS, todov1.Status_STATUS_COMPLETED, true},
+		// not transitive
+		{todov1.Status_STATUS_INCOMPLETE, todov1.Status_STATUS_COMPLETED, false},
+		// not symmetric
+		{todov1.Status_STATUS_IN_PROGRESS, todov1.Status_STATUS_INCOMPLETE, false},
+		// a status without rules is final
+		{todov1.Status_STATUS_COMPLETED, todov1.Status_STATUS_INCOMPLETE, false},
+		// staying put is always allowed
+		{todov1.Status_STATUS_COMPLETED, todov1.Status_STATUS_COMPLETED, true},
+		{todov1.Status_STATUS_BLOCKED, todov1.Status_STATUS_BLOCKED, true},
+	}
+	for _, tt := range tests {
+		if got := graph.Allows(tt.from, tt.to); got != tt.want {
+			t.Errorf("Allows(%s, %s) = %t, want %t", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestDefaultTransitions(t *testing.T) {
+	graph := DefaultTransitions()
+	for _, info := range repository.Statuses {
+		if _, ok := graph[info.Status]; !ok {
+			t.Errorf("no transitions out of %s", info.Name)
+		}
+		for _, to := range graph[info.Status] {
+			if !validStatus(to) {
+				t.Errorf("%s -> %s, which is not a valid status", info.Name, to)
+			}
+		}
+	}
+	if graph.Allows(todov1.Status_STATUS_COMPLETED, todov1.Status_STATUS_CANCELLED) {
+		t.Error("completed todos can be cancelled")
+	}
+}
@@ -0,0 +1,259 @@
+// Package service holds the business rules of the todo API: the status
+// workflow, dependencies, ordering and quotas, and the comments and
+// attachments of todos. It stores them through the repository interfaces
+// and returns Connect errors.
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/internal/apierr"
+	"github.com/kogamitora/todo/internal/logging"
+	"github.com/kogamitora/todo/internal/repository"
+)
+
+// Todo is a todo with the todos blocking it.
+type Todo struct {
+	repository.Todo
+	// BlockedBy lists the IDs of the todos that block it, except deleted ones.
+	BlockedBy []int64
+}
+
+// TodoService
+type TodoService struct {
+	todos       repository.TodoRepository
+	deps        repository.DependencyRepository
+	logger      *slog.Logger
+	transitions TransitionGraph
+	// todoQuota caps the todos per user that are not deleted, whatever their
+	// status; zero means no cap.
+	todoQuota int
+}
+
+func NewTodoService(todos repository.TodoRepository, deps repository.DependencyRepository, logger *slog.Logger, transitions TransitionGraph, todoQuota int) *TodoService {
+	return &TodoService{
+		todos:       todos,
+		deps:        deps,
+		logger:      logger,
+		transitions: transitions,
+		todoQuota:   todoQuota,
+	}
+}
+
+// log returns the request-scoped logger set by the logging interceptor.
+func (s *TodoService) log(ctx context.Context) *slog.Logger {
+	return logging.FromContext(ctx, s.logger)
+}
+
+// find finds a todo by its ID and handles common errors.
+func (s *TodoService) find(ctx context.Context, id int64) (*repository.Todo, error) {
+	todo, err := s.todos.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("todo with id %d not found", id))
+		}
+		s.log(ctx).ErrorContext(ctx, "failed to find todo", "id", id, "error", err)
+		return nil, apierr.Convert(err)
+	}
+	return todo, nil
+}
+
+// checkTodoExists returns CodeNotFound if the todo is missing or deleted,
+// for the services that hang records off a todo.
+func checkTodoExists(ctx context.Context, todos repository.TodoRepository, logger *slog.Logger, id int64) error {
+	todo, err := todos.Get(ctx, id)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		logger.ErrorContext(ctx, "failed to find todo", "id", id, "error", err)
+		return apierr.Convert(err)
+	}
+	if err != nil || todo.Deleted() {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("todo with id %d not found", id))
+	}
+	return nil
+}
+
+// checkQuota fails once the user owns todoQuota todos that are not deleted,
+// done ones included. Concurrent creates may overshoot the quota by a few
+// todos; it is a guard, not an invariant. The user is the X-User header,
+// which nothing verifies: a client that sends another name gets another
+// quota, so the quota stops mistakes, not abuse.
+func (s *TodoService) checkQuota(ctx context.Context, user string) error {
+	if s.todoQuota == 0 || user == "" {
+		return nil
+	}
+	count, err := s.todos.CountOwned(ctx, user)
+	if err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to count todos", "error", err)
+		return apierr.Convert(err)
+	}
+	if count >= int64(s.todoQuota) {
+		return apierr.QuotaExceeded("user:"+user, fmt.Sprintf("todo quota of %d reached; delete some todos first", s.todoQuota))
+	}
+	return nil
+}
+
+// CreateParams are the fields of a new todo.
+type CreateParams struct {
+	Title       string
+	Description string
+	DueDate     time.Time // zero for none
+	// CreatedBy is the user creating the todo, counted against its quota.
+	CreatedBy string
+}
+
+// Create adds an incomplete todo at the end of its column.
+// With a quota, it needs a user: anonymous clients would otherwise share
+// one quota, and one of them could use it up for all the others.
+func (s *TodoService) Create(ctx context.Context, p CreateParams) (*Todo, error) {
+	if s.todoQuota > 0 && p.CreatedBy == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("the todo quota needs a user: set the X-User header"))
+	}
+	if err := s.checkQuota(ctx, p.CreatedBy); err != nil {
+		return nil, err
+	}
+
+	todo := &repository.Todo{
+		Title:       p.Title,
+		Description: p.Description,
+		DueDate:     p.DueDate,
+		Status:      todov1.Status_STATUS_INCOMPLETE,
+		CreatedBy:   p.CreatedBy,
+	}
+	position, err := s.lastPosition(ctx, todo.Status)
+	if err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to compute position", "error", err)
+		return nil, apierr.Convert(err)
+	}
+	todo.Position = position
+
+	if err := s.todos.Create(ctx, todo); err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to insert todo", "error", err)
+		return nil, apierr.Convert(err)
+	}
+	// a new todo has no blockers
+	return &Todo{Todo: *todo}, nil
+}
+
+// Get returns a todo, including a deleted one.
+func (s *TodoService) Get(ctx context.Context, id int64) (*Todo, error) {
+	todo, err := s.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.withBlockers(ctx, todo)
+}
+
+// UpdateParams lists the fields to change; nil fields are kept.
+type UpdateParams struct {
+	Title       *string
+	Description *string
+	DueDate     *time.Time
+	Status      *todov1.Status
+}
+
+// Update changes a todo. A status change must be allowed by the workflow,
+// and completing a todo requires its blockers to be finished.
+func (s *TodoService) Update(ctx context.Context, id int64, p UpdateParams) (*Todo, error) {
+	todo, err := s.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Title != nil {
+		todo.Title = *p.Title
+	}
+	if p.Description != nil {
+		todo.Description = *p.Description
+	}
+	if p.DueDate != nil {
+		todo.DueDate = *p.DueDate
+	}
+	if p.Status != nil {
+		status := *p.Status
+		if !validStatus(status) {
+			return nil, apierr.Invalidf("status", "invalid status %s", status)
+		}
+		current := todo.Status
+		if !s.transitions.Allows(current, status) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition,
+				fmt.Errorf("cannot change status from %s to %s", statusName(current), statusName(status)))
+		}
+		if status == todov1.Status_STATUS_COMPLETED && current != todov1.Status_STATUS_COMPLETED {
+			open, err := s.deps.CountOpenBlockers(ctx, todo.ID)
+			if err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to count blockers", "id", todo.ID, "error", err)
+				return nil, apierr.Convert(err)
+			}
+			if open > 0 {
+				return nil, connect.NewError(connect.CodeFailedPrecondition,
+					fmt.Errorf("todo %d is still blocked by %d open todo(s)", todo.ID, open))
+			}
+		}
+		if current != status {
+			// moving to another column puts the todo at its end
+			position, err := s.lastPosition(ctx, status)
+			if err != nil {
+				s.log(ctx).ErrorContext(ctx, "failed to compute position", "error", err)
+				return nil, apierr.Convert(err)
+			}
+			todo.Position = position
+		}
+		todo.Status = status
+	}
+
+	if err := s.todos.Update(ctx, todo); err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to update todo", "error", err)
+		return nil, apierr.Convert(err)
+	}
+	return s.withBlockers(ctx, todo)
+}
+
+// Delete soft deletes a todo.
+func (s *TodoService) Delete(ctx context.Context, id int64) error {
+	if _, err := s.find(ctx, id); err != nil {
+		return err
+	}
+	if err := s.todos.Delete(ctx, id); err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to soft delete todo", "error", err)
+		return apierr.Convert(err)
+	}
+	return nil
+}
+
+// Restore brings back a deleted todo. It counts against the quota again,
+// unless it was created without a user.
+func (s *TodoService) Restore(ctx context.Context, id int64) (*Todo, error) {
+	todo, err := s.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !todo.Deleted() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("todo %d is not deleted", id))
+	}
+	if err := s.checkQuota(ctx, todo.CreatedBy); err != nil {
+		return nil, err
+	}
+	if err := s.todos.Restore(ctx, id); err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to restore todo", "error", err)
+		return nil, apierr.Convert(err)
+	}
+	todo.DeletedAt = time.Time{}
+	return s.withBlockers(ctx, todo)
+}
+
+// List returns the todos selected by opts that are not deleted.
+func (s *TodoService) List(ctx context.Context, opts repository.ListOptions) ([]*Todo, error) {
+	todos, err := s.todos.List(ctx, opts)
+	if err != nil {
+		s.log(ctx).ErrorContext(ctx, "failed to list todos", "error", err)
+		return nil, apierr.Convert(err)
+	}
+	return s.attachBlockers(ctx, todos...)
+}
@@ -0,0 +1,613 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/internal/repository"
+)
+
+// fakeStore is a minimal in-memory TodoRepository and DependencyRepository.
+// List ignores the sort and the filters.
+type fakeStore struct {
+	todos map[int64]*repository.Todo
+	deps  []repository.Dependency
+	next  int64
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{todos: map[int64]*repository.Todo{}}
+}
+
+func (f *fakeStore) Get(_ context.Context, id int64) (*repository.Todo, error) {
+	t, ok := f.todos[id]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+	c := *t
+	return &c, nil
+}
+
+func (f *fakeStore) List(_ context.Context, _ repository.ListOptions) ([]*repository.Todo, error) {
+	var list []*repository.Todo
+	for id := int64(1); id <= f.next; id++ {
+		if t, ok := f.todos[id]; ok && !t.Deleted() {
+			c := *t
+			list = append(list, &c)
+		}
+	}
+	return list, nil
+}
+
+func (f *fakeStore) Create(_ context.Context, todo *repository.Todo) error {
+	f.next++
+	todo.ID = f.next
+	todo.CreatedAt = time.Now()
+	todo.UpdatedAt = todo.CreatedAt
+	c := *todo
+	f.todos[todo.ID] = &c
+	return nil
+}
+
+func (f *fakeStore) Update(_ context.Context, todo *repository.Todo) error {
+	t, ok := f.todos[todo.ID]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	t.Title, t.Description, t.DueDate = todo.Title, todo.Description, todo.DueDate
+	t.Status, t.Position = todo.Status, todo.Position
+	t.UpdatedAt = time.Now()
+	todo.UpdatedAt = t.UpdatedAt
+	return nil
+}
+
+func (f *fakeStore) Delete(_ context.Context, id int64) error {
+	t, ok := f.todos[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	t.DeletedAt = time.Now()
+	return nil
+}
+
+func (f *fakeStore) Restore(_ context.Context, id int64) error {
+	t, ok := f.todos[id]
+	if !ok {
+		return repository.ErrNotFound
+	}
+	t.DeletedAt = time.Time{}
+	return nil
+}
+
+func (f *fakeStore) CountOwned(_ context.Context, owner string) (int64, error) {
+	var n int64
+	for _, t := range f.todos {
+		if t.CreatedBy == owner && !t.Deleted() {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (f *fakeStore) CountOpen(_ context.Context, now time.Time) (open, overdue int64, err error) {
+	for _, t := range f.todos {
+		if t.Deleted() || t.Status == todov1.Status_STATUS_COMPLETED || t.Status == todov1.Status_STATUS_CANCELLED {
+			continue
+		}
+		open++
+		if !t.DueDate.IsZero() && t.DueDate.Before(now) {
+			overdue++
+		}
+	}
+	return open, overdue, nil
+}
+
+func (f *fakeStore) LastPosition(_ context.Context, status todov1.Status) (string, error) {
+	last := ""
+	for _, t := range f.todos {
+		if t.Status == status && t.Position > last {
+			last = t.Position
+		}
+	}
+	return last, nil
+}
+
+func (f *fakeStore) NeighborPosition(_ context.Context, status todov1.Status, position string, excludeID int64, before bool) (string, error) {
+	found := ""
+	for _, t := range f.todos {
+		if t.Status != status || t.ID == excludeID {
+			continue
+		}
+		if before && t.Position < position && t.Position > found {
+			found = t.Position
+		}
+		if !before && t.Position > position && (found == "" || t.Position < found) {
+			found = t.Position
+		}
+	}
+	return found, nil
+}
+
+func (f *fakeStore) RenumberPositions(_ context.Context, status todov1.Status, keys func(n int) []string) error {
+	var column []*repository.Todo
+	for _, t := range f.todos {
+		if t.Status == status {
+			column = append(column, t)
+		}
+	}
+	slices.SortFunc(column, func(x, y *repository.Todo) int {
+		return cmp.Or(strings.Compare(x.Position, y.Position), cmp.Compare(x.ID, y.ID))
+	})
+	for i, position := range keys(len(column)) {
+		column[i].Position = position
+	}
+	return nil
+}
+
+func (f *fakeStore) Add(ctx context.Context, dep repository.Dependency) error {
+	if slices.Contains(f.deps, dep) {
+		return repository.ErrAlreadyExists
+	}
+	if cycle, _ := repository.Reaches(ctx, dep.BlockedByID, dep.TodoID, f.Blockers); cycle {
+		return repository.ErrCycle
+	}
+	f.deps = append(f.deps, dep)
+	return nil
+}
+
+func (f *fakeStore) Remove(_ context.Context, dep repository.Dependency) error {
+	i := slices.Index(f.deps, dep)
+	if i < 0 {
+		return repository.ErrNotFound
+	}
+	f.deps = slices.Delete(f.deps, i, i+1)
+	return nil
+}
+
+func (f *fakeStore) Blockers(_ context.Context, todoIDs ...int64) ([]repository.Dependency, error) {
+	var list []repository.Dependency
+	for _, d := range f.deps {
+		if slices.Contains(todoIDs, d.TodoID) {
+			list = append(list, d)
+		}
+	}
+	return list, nil
+}
+
+func (f *fakeStore) ActiveBlockers(ctx context.Context, todoIDs ...int64) ([]repository.Dependency, error) {
+	deps, _ := f.Blockers(ctx, todoIDs...)
+	return slices.DeleteFunc(deps, func(d repository.Dependency) bool {
+		return f.todos[d.BlockedByID].Deleted()
+	}), nil
+}
+
+func (f *fakeStore) CountOpenBlockers(ctx context.Context, todoID int64) (int64, error) {
+	deps, _ := f.ActiveBlockers(ctx, todoID)
+	var n int64
+	for _, d := range deps {
+		switch f.todos[d.BlockedByID].Status {
+		case todov1.Status_STATUS_COMPLETED, todov1.Status_STATUS_CANCELLED:
+		default:
+			n++
+		}
+	}
+	return n, nil
+}
+
+func newTestService(t *testing.T, quota int) (*TodoService, *fakeStore) {
+	t.Helper()
+	store := newFakeStore()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewTodoService(store, store, logger, DefaultTransitions(), quota), store
+}
+
+func mustCreate(t *testing.T, s *TodoService, title string) *Todo {
+	t.Helper()
+	todo, err := s.Create(context.Background(), CreateParams{Title: title})
+	if err != nil {
+		t.Fatalf("Create(%q): %v", title, err)
+	}
+	return todo
+}
+
+func wantCode(t *testing.T, err error, code connect.Code) {
+	t.Helper()
+	if got := connect.CodeOf(err); err == nil || got != code {
+		t.Fatalf("error = %v, want code %s", err, code)
+	}
+}
+
+func TestCreateAppendsToColumn(t *testing.T) {
+	s, _ := newTestService(t, 0)
+	a := mustCreate(t, s, "a")
+	b := mustCreate(t, s, "b")
+	if a.Status != todov1.Status_STATUS_INCOMPLETE {
+		t.Errorf("status = %s, want incomplete", a.Status)
+	}
+	if a.Position >= b.Position {
+		t.Errorf("positions %q, %q are not increasing", a.Position, b.Position)
+	}
+}
+
+func TestQuota(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService(t, 1)
+	a, err := s.Create(ctx, CreateParams{Title: "a", CreatedBy: "bob"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// done todos count too
+	if _, err := s.Update(ctx, a.ID, UpdateParams{Status: todov1.Status_STATUS_COMPLETED.Enum()}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = s.Create(ctx, CreateParams{Title: "b", CreatedBy: "bob"})
+	wantCode(t, err, connect.CodeResourceExhausted)
+	// another user has its own quota
+	if _, err := s.Create(ctx, CreateParams{Title: "c", CreatedBy: "alice"}); err != nil {
+		t.Fatalf("Create for alice: %v", err)
+	}
+
+	if err := s.Delete(ctx, a.ID); err != nil {
+		t.Fatal(err)
+	}
+	b, err := s.Create(ctx, CreateParams{Title: "b", CreatedBy: "bob"})
+	if err != nil {
+		t.Fatalf("Create after delete: %v", err)
+	}
+	// restoring a would exceed the quota again
+	_, err = s.Restore(ctx, a.ID)
+	wantCode(t, err, connect.CodeResourceExhausted)
+
+	if err := s.Delete(ctx, b.ID); err != nil {
+		t.Fatal(err)
+	}
+	restored, err := s.Restore(ctx, a.ID)
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if restored.Deleted() {
+		t.Error("restored todo is still deleted")
+	}
+}
+
+func TestQuotaNeedsUser(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService(t, 1)
+	_, err := s.Create(ctx, CreateParams{Title: "a"})
+	wantCode(t, err, connect.CodeUnauthenticated)
+
+	// without a quota anyone may create todos
+	s, _ = newTestService(t, 0)
+	a := mustCreate(t, s, "a")
+	mustCreate(t, s, "b")
+	if err := s.Delete(ctx, a.ID); err != nil {
+		t.Fatal(err)
+	}
+	// a todo without a user is restored whatever the quota
+	s.todoQuota = 1
+	if _, err := s.Restore(ctx, a.ID); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+}
+
+func TestRestoreNotDeleted(t *testing.T) {
+	s, _ := newTestService(t, 0)
+	a := mustCreate(t, s, "a")
+	_, err := s.Restore(context.Background(), a.ID)
+	wantCode(t, err, connect.CodeFailedPrecondition)
+}
+
+func TestUpdateStatus(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService(t, 0)
+	a := mustCreate(t, s, "a")
+
+	completed := todov1.Status_STATUS_COMPLETED
+	todo, err := s.Update(ctx, a.ID, UpdateParams{Status: &completed})
+	if err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+	if todo.Status != completed {
+		t.Errorf("status = %s, want completed", todo.Status)
+	}
+
+	// the default workflow only reopens completed todos
+	blocked := todov1.Status_STATUS_BLOCKED
+	_, err = s.Update(ctx, a.ID, UpdateParams{Status: &blocked})
+	wantCode(t, err, connect.CodeFailedPrecondition)
+
+	unspecified := todov1.Status_STATUS_UNSPECIFIED
+	_, err = s.Update(ctx, a.ID, UpdateParams{Status: &unspecified})
+	wantCode(t, err, connect.CodeInvalidArgument)
+
+	_, err = s.Update(ctx, 42, UpdateParams{Status: &completed})
+	wantCode(t, err, connect.CodeNotFound)
+}
+
+func TestCompleteRequiresFinishedBlockers(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService(t, 0)
+	a := mustCreate(t, s, "a")
+	b := mustCreate(t, s, "b")
+
+	todo, err := s.AddDependency(ctx, a.ID, b.ID)
+	if err != nil {
+		t.Fatalf("AddDependency: %v", err)
+	}
+	if !slices.Equal(todo.BlockedBy, []int64{b.ID}) {
+		t.Errorf("blocked by %v, want [%d]", todo.BlockedBy, b.ID)
+	}
+
+	completed := todov1.Status_STATUS_COMPLETED
+	_, err = s.Update(ctx, a.ID, UpdateParams{Status: &completed})
+	wantCode(t, err, connect.CodeFailedPrecondition)
+
+	if _, err := s.Update(ctx, b.ID, UpdateParams{Status: &completed}); err != nil {
+		t.Fatalf("complete blocker: %v", err)
+	}
+	if _, err := s.Update(ctx, a.ID, UpdateParams{Status: &completed}); err != nil {
+		t.Fatalf("complete after blocker: %v", err)
+	}
+}
+
+func TestDeletedBlockersAreHidden(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService(t, 0)
+	a := mustCreate(t, s, "a")
+	b := mustCreate(t, s, "b")
+	if _, err := s.AddDependency(ctx, a.ID, b.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Delete(ctx, b.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	todo, err := s.Get(ctx, a.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(todo.BlockedBy) != 0 {
+		t.Errorf("blocked by %v, want none", todo.BlockedBy)
+	}
+}
+
+func TestAddDependency(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestService(t, 0)
+	a := mustCreate(t, s, "a")
+	b := mustCreate(t, s, "b")
+	c := mustCreate(t, s, "c")
+
+	_, err := s.AddDependency(ctx, a.ID, a.ID)
+	wantCode(t, err, connect.CodeInvalidArgument)
+	_, err = s.AddDependency(ctx, a.ID, 42)
+	wantCode(t, err, connect.CodeNotFound)
+
+	if _, err := s.AddDependency(ctx, a.ID, b.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.AddDependency(ctx, b.ID, c.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.AddDependency(ctx, a.ID, b.ID)
+	wantCode(t, err, connect.CodeAlreadyExists)
+	// b -> a would close a -> b directly
+	_, err = s.AddDependency(ctx, b.ID, a.ID)
+	wantCode(t, err, connect.CodeFailedPrecondition)
+	// c -> a would close a -> b -> c
+	_, err = s.AddDependency(ctx, c.ID, a.ID)
+	wantCode(t, err, connect.CodeFailedPrecondition)
+
+	if _, err := s.RemoveDependency(ctx, a.ID, b.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.RemoveDependency(ctx, a.ID, b.ID)
+	wantCode(t, err, connect.CodeNotFound)
+}
+
+func TestMove(t *testing.T) {
+	ctx := context.Background()
+	s, store := newTestService(t, 0)
+	a := mustCreate(t, s, "a")
+	b := mustCreate(t, s, "b")
+	c := mustCreate(t, s, "c")
+
+	order := func() string {
+		todos, _ := store.List(ctx, repository.ListOptions{})
+		slices.SortFunc(todos, func(x, y *repository.Todo) int { return strings.Compare(x.Position, y.Position) })
+		var titles []string
+		for _, t := range todos {
+			titles = append(titles, t.Title)
+		}
+		return strings.Join(titles, "")
+	}
+
+	if _, err := s.Move(ctx, c.ID, &a.ID, nil); err != nil {
+		t.Fatalf("move c before a: %v", err)
+	}
+	if got := order(); got != "cab" {
+		t.Errorf("order = %s, want cab", got)
+	}
+	if _, err := s.Move(ctx, c.ID, nil, &a.ID); err != nil {
+		t.Fatalf("move c after a: %v", err)
+	}
+	if got := order(); got != "acb" {
+		t.Errorf("order = %s, want acb", got)
+	}
+
+	_, err := s.Move(ctx, c.ID, nil, nil)
+	wantCode(t, err, connect.CodeInvalidArgument)
+	_, err = s.Move(ctx, c.ID, &c.ID, nil)
+	wantCode(t, err, connect.CodeInvalidArgument)
+	// b comes after a, so nothing fits before a and after b
+	_, err = s.Move(ctx, c.ID, &a.ID, &b.ID)
+	wantCode(t, err, connect.CodeInvalidArgument)
+
+	inProgress := todov1.Status_STATUS_IN_PROGRESS
+	if _, err := s.Update(ctx, b.ID, UpdateParams{Status: &inProgress}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.Move(ctx, c.ID, &b.ID, nil)
+	wantCode(t, err, connect.CodeInvalidArgument)
+}
+
+func TestPositionBetween(t *testing.T) {
+	keys := []string{"", "Zz", "a0", "a0V", "a0Vz", "a0W", "a1", "az", "b00", "b00V", smallestInteger + "1"}
+	for _, a := range keys {
+		for _, b := range keys {
+			if b != "" && a >= b {
+				continue
+			}
+			got, err := positionBetween(a, b)
+			if err != nil {
+				t.Errorf("positionBetween(%q, %q): %v", a, b, err)
+				continue
+			}
+			if got <= a || (b != "" && got >= b) {
+				t.Errorf("positionBetween(%q, %q) = %q, not between", a, b, got)
+			}
+			if _, _, err := splitPosition(got); err != nil {
+				t.Errorf("positionBetween(%q, %q) = %q, not a valid key", a, b, got)
+			}
+		}
+	}
+	if _, err := positionBetween("b00", "a0"); !errors.Is(err, errPositionOrder) {
+		t.Errorf("positionBetween(b00, a0) = %v, want %v", err, errPositionOrder)
+	}
+	// keys of migration 000004 and of the first key format
+	for _, key := range []string{"000000000012V", "V", "a0V0", smallestInteger, "a!"} {
+		if _, err := positionBetween(key, ""); !errors.Is(err, errRebalance) {
+			t.Errorf("positionBetween(%q, \"\") = %v, want %v", key, err, errRebalance)
+		}
+	}
+}
+
+func TestPositionIntegers(t *testing.T) {
+	// counting through the two-digit integers crosses from the negative
+	// ones to the positive ones
+	key := "Y" + strings.Repeat("z", 2)
+	for range 2 * len(positionDigits) {
+		next, ok := incrementInteger(key)
+		if !ok || next <= key {
+			t.Fatalf("incrementInteger(%q) = %q, %v", key, next, ok)
+		}
+		if prev, ok := decrementInteger(next); !ok || prev != key {
+			t.Fatalf("decrementInteger(%q) = %q, %v, want %q", next, prev, ok, key)
+		}
+		key = next
+	}
+	if _, ok := incrementInteger("z" + strings.Repeat("z", 26)); ok {
+		t.Error("incremented the largest integer")
+	}
+	if _, ok := decrementInteger(smallestInteger); ok {
+		t.Error("decremented the smallest integer")
+	}
+}
+
+func TestPositionAppendsStayShort(t *testing.T) {
+	key := ""
+	for i := range 10000 {
+		next, err := positionBetween(key, "")
+		if err != nil {
+			t.Fatalf("append %d after %q: %v", i, key, err)
+		}
+		if next <= key {
+			t.Fatalf("append %d: %q is not after %q", i, next, key)
+		}
+		key = next
+	}
+	// 62 two-character keys, 62² three-character ones, then four characters
+	if len(key) > 4 {
+		t.Errorf("key after 10000 appends = %q, want at most 4 characters", key)
+	}
+}
+
+func TestPositionRepeatedInserts(t *testing.T) {
+	// inserting right after the same key again and again lengthens the
+	// fraction until a rebalance is needed
+	lower, upper := "a0", "a1"
+	for i := 0; ; i++ {
+		got, err := positionBetween(lower, upper)
+		if errors.Is(err, errRebalance) {
+			if i < 100 {
+				t.Errorf("rebalance needed after %d inserts", i)
+			}
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got <= lower || got >= upper || len(got) > maxPositionLength {
+			t.Fatalf("insert %d between %q and %q = %q", i, lower, upper, got)
+		}
+		upper = got
+	}
+}
+
+func TestMoveRebalances(t *testing.T) {
+	ctx := context.Background()
+	s, store := newTestService(t, 0)
+	first := mustCreate(t, s, "first")
+	last := mustCreate(t, s, "last")
+
+	// each todo goes right after first, before the one moved there last
+	var titles []string
+	for i := range 1000 {
+		todo := mustCreate(t, s, strconv.Itoa(i))
+		if _, err := s.Move(ctx, todo.ID, nil, &first.ID); err != nil {
+			t.Fatalf("move %d: %v", i, err)
+		}
+		titles = append(titles, todo.Title)
+	}
+	slices.Reverse(titles)
+	want := append(append([]string{first.Title}, titles...), last.Title)
+
+	todos, _ := store.List(ctx, repository.ListOptions{})
+	slices.SortFunc(todos, func(x, y *repository.Todo) int { return strings.Compare(x.Position, y.Position) })
+	var got []string
+	for _, todo := range todos {
+		if len(todo.Position) > maxPositionLength {
+			t.Errorf("todo %q has position %q", todo.Title, todo.Position)
+		}
+		got = append(got, todo.Title)
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("order = %v, want %v", got, want)
+	}
+}
+
+func TestCreateRebalancesLegacyPositions(t *testing.T) {
+	ctx := context.Background()
+	s, store := newTestService(t, 0)
+	a := mustCreate(t, s, "a")
+	b := mustCreate(t, s, "b")
+	// positions as migration 000004 set them
+	store.todos[a.ID].Position = "000000000001V"
+	store.todos[b.ID].Position = "000000000002V"
+
+	c := mustCreate(t, s, "c")
+	if _, err := s.Move(ctx, a.ID, nil, &b.ID); err != nil {
+		t.Fatal(err)
+	}
+	positions := []string{store.todos[b.ID].Position, store.todos[a.ID].Position, c.Position}
+	if !slices.IsSorted(positions) {
+		t.Errorf("positions of b, a, c = %q, want increasing", positions)
+	}
+	for _, p := range positions {
+		if _, _, err := splitPosition(p); err != nil {
+			t.Errorf("position %q was not renumbered", p)
+		}
+	}
+}
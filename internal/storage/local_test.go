@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// failingReader returns some data and then an error, like an upload that
+// breaks off.
+type failingReader struct {
+	data string
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.data == "" {
+		return 0, errors.New("connection reset")
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func read(t *testing.T, s *LocalStore, key string) string {
+	t.Helper()
+	rc, err := s.Get(context.Background(), key)
+	if err != nil {
+		t.Fatalf("Get(%q): %v", key, err)
+	}
+	defer rc.Close()
+	b, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s, err := NewLocalStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const key = "sha256/ab/abcdef"
+
+	if ok, err := s.Exists(ctx, key); err != nil || ok {
+		t.Errorf("Exists before Put = %v, %v, want false", ok, err)
+	}
+	if _, err := s.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get of a missing blob = %v, want %v", err, ErrNotFound)
+	}
+
+	if err := s.Put(ctx, key, strings.NewReader("hello"), 5); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := s.Exists(ctx, key); err != nil || !ok {
+		t.Errorf("Exists after Put = %v, %v, want true", ok, err)
+	}
+	if got := read(t, s, key); got != "hello" {
+		t.Errorf("Get = %q, want hello", got)
+	}
+
+	// failed writes leave the stored blob alone and no temporary files behind
+	if err := s.Put(ctx, key, &failingReader{data: "hel"}, 5); err == nil {
+		t.Error("Put from a failing reader succeeded")
+	}
+	if err := s.Put(ctx, key, strings.NewReader("hell"), 5); err == nil {
+		t.Error("Put of fewer bytes than the size succeeded")
+	}
+	if got := read(t, s, key); got != "hello" {
+		t.Errorf("Get after failed writes = %q, want hello", got)
+	}
+	entries, err := os.ReadDir(filepath.Join(dir, "sha256", "ab"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "abcdef" {
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+		t.Errorf("files = %v, want only the blob", names)
+	}
+
+	// a failed first write stores nothing
+	if err := s.Put(ctx, "sha256/cd/cdef", &failingReader{data: "x"}, 2); err == nil {
+		t.Error("Put from a failing reader succeeded")
+	}
+	if ok, err := s.Exists(ctx, "sha256/cd/cdef"); err != nil || ok {
+		t.Errorf("Exists after a failed Put = %v, %v, want false", ok, err)
+	}
+}
+
+func TestNewLocalStoreRequiresDir(t *testing.T) {
+	if _, err := NewLocalStore(""); err == nil {
+		t.Error("NewLocalStore(\"\") succeeded")
+	}
+}
@@ -0,0 +1,111 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/gen/proto/todo/v1/v1connect"
+)
+
+type getTodoHandler struct {
+	v1connect.UnimplementedTodoServiceHandler
+	logger *slog.Logger
+}
+
+func (h *getTodoHandler) GetTodo(ctx context.Context, req *connect.Request[todov1.GetTodoRequest]) (*connect.Response[todov1.GetTodoResponse], error) {
+	h.logger.InfoContext(ctx, "GetTodo called", "id", req.Msg.Id)
+	return connect.NewResponse(&todov1.GetTodoResponse{Todo: &todov1.Todo{Id: req.Msg.Id}}), nil
+}
+
+type exportedSpan struct {
+	Name        string
+	SpanContext struct{ TraceID, SpanID string }
+	Parent      struct{ TraceID, SpanID string }
+}
+
+func TestTraceContextPropagatesFromClientToServerLogs(t *testing.T) {
+	var spans bytes.Buffer
+	exporter, err := stdouttrace.New(stdouttrace.WithWriter(&spans))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tp := NewTracerProvider(exporter, "test", 1)
+	SetGlobal(tp)
+
+	var logs bytes.Buffer
+	logger := slog.New(NewLogHandler(slog.NewJSONHandler(&logs, nil)))
+
+	serverInterceptor, err := NewServerInterceptor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle(v1connect.NewTodoServiceHandler(&getTodoHandler{logger: logger}, connect.WithInterceptors(serverInterceptor)))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	clientInterceptor, err := NewClientInterceptor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := v1connect.NewTodoServiceClient(srv.Client(), srv.URL, connect.WithInterceptors(clientInterceptor))
+	if _, err := client.GetTodo(context.Background(), connect.NewRequest(&todov1.GetTodoRequest{Id: 1})); err != nil {
+		t.Fatal(err)
+	}
+	if err := tp.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	var exported []exportedSpan
+	dec := json.NewDecoder(&spans)
+	for {
+		var s exportedSpan
+		if err := dec.Decode(&s); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		exported = append(exported, s)
+	}
+	const procedure = "todo.v1.TodoService/GetTodo"
+	var clientSpan, serverSpan *exportedSpan
+	for i, s := range exported {
+		if s.Name != procedure {
+			continue
+		}
+		if strings.Trim(s.Parent.SpanID, "0") == "" {
+			clientSpan = &exported[i]
+		} else {
+			serverSpan = &exported[i]
+		}
+	}
+	if clientSpan == nil || serverSpan == nil {
+		t.Fatalf("want a client and a server span, got %+v", exported)
+	}
+	if serverSpan.SpanContext.TraceID != clientSpan.SpanContext.TraceID || serverSpan.Parent.SpanID != clientSpan.SpanContext.SpanID {
+		t.Fatalf("server span %+v is not a child of client span %+v", serverSpan, clientSpan)
+	}
+
+	var record struct {
+		TraceID string `json:"trace_id"`
+		SpanID  string `json:"span_id"`
+	}
+	if err := json.Unmarshal(logs.Bytes(), &record); err != nil {
+		t.Fatal(err)
+	}
+	if record.TraceID != serverSpan.SpanContext.TraceID || record.SpanID != serverSpan.SpanContext.SpanID {
+		t.Fatalf("log record has trace_id=%s span_id=%s, want the server span %+v", record.TraceID, record.SpanID, serverSpan)
+	}
+}
@@ -0,0 +1,87 @@
+// Package testserver runs the todo services in-process on an httptest
+// listener, so that the handlers and the CLI can be tested end to end
+// without a database or a separate server.
+package testserver
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	todov1connect "github.com/kogamitora/todo/gen/proto/todo/v1/v1connect"
+	"github.com/kogamitora/todo/internal/apierr"
+	"github.com/kogamitora/todo/internal/handler"
+	"github.com/kogamitora/todo/internal/logging"
+	"github.com/kogamitora/todo/internal/repository"
+	"github.com/kogamitora/todo/internal/repository/memory"
+	"github.com/kogamitora/todo/internal/service"
+	"github.com/kogamitora/todo/internal/storage"
+	"github.com/kogamitora/todo/internal/validation"
+)
+
+// maxAttachmentSize is the attachment limit of the test server, the same
+// as the server's default.
+const maxAttachmentSize = 10 << 20
+
+// Server is a running test server with a typed client for each service.
+// It is closed when the test ends.
+type Server struct {
+	*httptest.Server
+	// Repos is the store behind the server, to prepare or inspect data
+	// without going through the API.
+	Repos repository.Repositories
+
+	Todos       todov1connect.TodoServiceClient
+	Comments    todov1connect.CommentServiceClient
+	Attachments todov1connect.AttachmentServiceClient
+}
+
+// NewMemory starts a server on a new, empty in-memory store.
+func NewMemory(t *testing.T) *Server {
+	return New(t, memory.NewRepositories())
+}
+
+// New starts a server on repos. Requests go through the same request ID,
+// error and validation interceptors as in cmd/server; tracing, metrics and
+// rate limits are left out. Attachments are kept in a temporary directory.
+func New(t *testing.T, repos repository.Repositories) *Server {
+	t.Helper()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	blobs, err := storage.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator, err := validation.NewInterceptor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	interceptors := connect.WithInterceptors(
+		logging.NewInterceptor(logger),
+		apierr.NewInterceptor(logger),
+		validator,
+	)
+
+	svc := service.NewTodoService(repos.Todos, repos.Dependencies, logger, service.DefaultTransitions(), 0)
+	mux := http.NewServeMux()
+	mux.Handle(todov1connect.NewTodoServiceHandler(handler.NewTodoHandler(svc), interceptors))
+	mux.Handle(todov1connect.NewCommentServiceHandler(handler.NewCommentHandler(service.NewCommentService(repos.Comments, repos.Todos, logger)), interceptors))
+	mux.Handle(todov1connect.NewAttachmentServiceHandler(
+		handler.NewAttachmentHandler(service.NewAttachmentService(repos.Attachments, repos.Todos, blobs, maxAttachmentSize, logger)),
+		interceptors,
+	))
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return &Server{
+		Server:      srv,
+		Repos:       repos,
+		Todos:       todov1connect.NewTodoServiceClient(srv.Client(), srv.URL),
+		Comments:    todov1connect.NewCommentServiceClient(srv.Client(), srv.URL),
+		Attachments: todov1connect.NewAttachmentServiceClient(srv.Client(), srv.URL),
+	}
+}
@@ -0,0 +1,132 @@
+package testserver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/internal/logging"
+)
+
+func TestTodoRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	srv := NewMemory(t)
+
+	created, err := srv.Todos.CreateTodo(ctx, connect.NewRequest(&todov1.CreateTodoRequest{Title: "write tests"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Header().Get(logging.RequestIDHeader) == "" {
+		t.Error("response has no request ID")
+	}
+
+	got, err := srv.Todos.GetTodo(ctx, connect.NewRequest(&todov1.GetTodoRequest{Id: created.Msg.Todo.Id}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Msg.Todo.Title != "write tests" || got.Msg.Todo.Status != todov1.Status_STATUS_INCOMPLETE {
+		t.Errorf("GetTodo = %v", got.Msg.Todo)
+	}
+
+	stored, err := srv.Repos.Todos.Get(ctx, created.Msg.Todo.Id)
+	if err != nil || stored.Title != "write tests" {
+		t.Errorf("stored todo = %+v, %v", stored, err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	ctx := context.Background()
+	srv := NewMemory(t)
+
+	for _, tt := range []struct {
+		name string
+		call func() error
+		want connect.Code
+	}{
+		{"validation", func() error {
+			_, err := srv.Todos.CreateTodo(ctx, connect.NewRequest(&todov1.CreateTodoRequest{}))
+			return err
+		}, connect.CodeInvalidArgument},
+		{"missing todo", func() error {
+			_, err := srv.Todos.GetTodo(ctx, connect.NewRequest(&todov1.GetTodoRequest{Id: 1}))
+			return err
+		}, connect.CodeNotFound},
+		{"comment on a missing todo", func() error {
+			req := connect.NewRequest(&todov1.AddCommentRequest{TodoId: 1, Body: "hi"})
+			req.Header().Set(logging.UserHeader, "alice")
+			_, err := srv.Comments.AddComment(ctx, req)
+			return err
+		}, connect.CodeNotFound},
+		{"comment without a user", func() error {
+			_, err := srv.Comments.AddComment(ctx, connect.NewRequest(&todov1.AddCommentRequest{TodoId: 1, Body: "hi"}))
+			return err
+		}, connect.CodeUnauthenticated},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var connectErr *connect.Error
+			if err := tt.call(); !errors.As(err, &connectErr) || connectErr.Code() != tt.want {
+				t.Fatalf("error = %v, want code %s", err, tt.want)
+			}
+			if connectErr.Meta().Get(logging.RequestIDHeader) == "" {
+				t.Error("error has no request ID")
+			}
+		})
+	}
+}
+
+func TestAttachmentStreams(t *testing.T) {
+	ctx := context.Background()
+	srv := NewMemory(t)
+
+	todo, err := srv.Todos.CreateTodo(ctx, connect.NewRequest(&todov1.CreateTodoRequest{Title: "a"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content := bytes.Repeat([]byte("0123456789"), 10000)
+	upload := srv.Attachments.UploadAttachment(ctx)
+	if err := upload.Send(&todov1.UploadAttachmentRequest{
+		Payload: &todov1.UploadAttachmentRequest_Info{Info: &todov1.UploadAttachmentInfo{
+			TodoId:      todo.Msg.Todo.Id,
+			Filename:    "notes.txt",
+			ContentType: "text/plain",
+		}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	for chunk := range slices.Chunk(content, 64*1024) {
+		if err := upload.Send(&todov1.UploadAttachmentRequest{
+			Payload: &todov1.UploadAttachmentRequest_Chunk{Chunk: chunk},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	res, err := upload.CloseAndReceive()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Msg.Attachment.Size != int64(len(content)) {
+		t.Errorf("uploaded %d bytes, want %d", res.Msg.Attachment.Size, len(content))
+	}
+
+	download, err := srv.Attachments.DownloadAttachment(ctx, connect.NewRequest(&todov1.DownloadAttachmentRequest{Id: res.Msg.Attachment.Id}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer download.Close()
+	var got bytes.Buffer
+	for download.Receive() {
+		got.Write(download.Msg().GetChunk())
+	}
+	if err := download.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.Bytes(), content) {
+		t.Errorf("downloaded %d bytes that differ from the %d uploaded", got.Len(), len(content))
+	}
+}
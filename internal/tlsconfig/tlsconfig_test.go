@@ -0,0 +1,222 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"log"
+	"log/slog"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kogamitora/todo/internal/config"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: cert, key: key}
+}
+
+// issue writes a certificate signed by the CA and its key to dir and
+// returns their paths.
+func (ca *testCA) issue(t *testing.T, dir, name string, serial int64, usage x509.ExtKeyUsage) (certFile, keyFile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile = filepath.Join(dir, name+".crt")
+	keyFile = filepath.Join(dir, name+".key")
+	writePEM(t, certFile, "CERTIFICATE", der)
+	writePEM(t, keyFile, "EC PRIVATE KEY", keyDER)
+	return certFile, keyFile
+}
+
+func (ca *testCA) writeCert(t *testing.T, dir string) string {
+	t.Helper()
+	file := filepath.Join(dir, "ca.crt")
+	writePEM(t, file, "CERTIFICATE", ca.cert.Raw)
+	return file
+}
+
+func writePEM(t *testing.T, file, blockType string, der []byte) {
+	t.Helper()
+	if err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// startServer serves over a TLS listener like server.Server does;
+// httptest.Server.StartTLS would install a certificate of its own. The
+// handlers answer with the protocol, /rpc only to clients with a
+// certificate.
+func startServer(t *testing.T, cfg config.TLSConfig) string {
+	t.Helper()
+	tlsConfig, err := NewServer(cfg, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	proto := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, r.Proto)
+	})
+	mux := http.NewServeMux()
+	mux.Handle("/", proto)
+	mux.Handle("/rpc", RequireClientCert(proto))
+	srv := &http.Server{
+		Handler:   mux,
+		TLSConfig: tlsConfig,
+		ErrorLog:  log.New(io.Discard, "", 0),
+	}
+	go srv.Serve(tls.NewListener(lis, tlsConfig))
+	t.Cleanup(func() { srv.Close() })
+	return "https://" + lis.Addr().String()
+}
+
+func get(t *testing.T, url string, tlsConfig *tls.Config) (*http.Response, error) {
+	t.Helper()
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig, ForceAttemptHTTP2: true}}
+	defer client.CloseIdleConnections()
+	res, err := client.Get(url)
+	if err == nil {
+		res.Body.Close()
+	}
+	return res, err
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	caFile := ca.writeCert(t, dir)
+	serverCert, serverKey := ca.issue(t, dir, "server", 2, x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := ca.issue(t, dir, "client", 3, x509.ExtKeyUsageClientAuth)
+
+	url := startServer(t, config.TLSConfig{CertFile: serverCert, KeyFile: serverKey, ClientCAFile: caFile})
+
+	withoutCert, err := NewClient(caFile, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// probes reach the health endpoints without a certificate
+	if res, err := get(t, url+"/healthz", withoutCert); err != nil || res.StatusCode != http.StatusOK {
+		t.Fatalf("health check without a client certificate = %v, %v", res, err)
+	}
+	if res, err := get(t, url+"/rpc", withoutCert); err != nil || res.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("RPC without a client certificate = %v, %v, want 401", res, err)
+	}
+
+	withCert, err := NewClient(caFile, clientCert, clientKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := get(t, url+"/rpc", withCert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK || res.ProtoMajor != 2 {
+		t.Errorf("response = %s %s, want 200 over HTTP/2", res.Status, res.Proto)
+	}
+
+	// a certificate of another CA is refused during the handshake
+	other := newTestCA(t)
+	otherDir := t.TempDir()
+	otherCert, otherKey := other.issue(t, otherDir, "client", 5, x509.ExtKeyUsageClientAuth)
+	withOtherCert, err := NewClient(caFile, otherCert, otherKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := get(t, url+"/healthz", withOtherCert); err == nil {
+		t.Error("request with a certificate of another CA succeeded")
+	}
+
+	if _, err := NewClient(caFile, clientCert, ""); err == nil {
+		t.Error("NewClient accepted a certificate without a key")
+	}
+}
+
+func TestServerReloadsCertificate(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	caFile := ca.writeCert(t, dir)
+	certFile, keyFile := ca.issue(t, dir, "server", 2, x509.ExtKeyUsageServerAuth)
+
+	url := startServer(t, config.TLSConfig{CertFile: certFile, KeyFile: keyFile})
+	client, err := NewClient(caFile, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial := func() int64 {
+		t.Helper()
+		res, err := get(t, url, client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.TLS.PeerCertificates[0].SerialNumber.Int64()
+	}
+	if got := serial(); got != 2 {
+		t.Fatalf("serial = %d, want 2", got)
+	}
+
+	// renew the certificate in place, as cert-manager or certbot would
+	time.Sleep(checkInterval)
+	ca.issue(t, dir, "server", 4, x509.ExtKeyUsageServerAuth)
+	if got := serial(); got != 4 {
+		t.Errorf("serial after renewal = %d, want 4", got)
+	}
+}
@@ -0,0 +1,90 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"buf.build/go/protovalidate"
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kogamitora/todo/internal/apierr"
+)
+
+// NewInterceptor returns an interceptor that checks every request message
+// against the protovalidate rules declared in the proto files, so that
+// invalid input is rejected before a handler touches the database.
+// Violations are returned as CodeInvalidArgument with a BadRequest detail.
+func NewInterceptor() (connect.Interceptor, error) {
+	validator, err := protovalidate.New()
+	if err != nil {
+		return nil, err
+	}
+	return &interceptor{validator: validator}, nil
+}
+
+type interceptor struct {
+	validator protovalidate.Validator
+}
+
+func (i *interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if !req.Spec().IsClient {
+			if err := i.validate(req.Any()); err != nil {
+				return nil, err
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, &streamingHandlerConn{StreamingHandlerConn: conn, interceptor: i})
+	}
+}
+
+// validate returns nil for messages without rules.
+func (i *interceptor) validate(msg any) error {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return nil
+	}
+	err := i.validator.Validate(m)
+	if err == nil {
+		return nil
+	}
+	var validationErr *protovalidate.ValidationError
+	if !errors.As(err, &validationErr) {
+		// a rule failed to compile or evaluate; that is our bug, not the caller's
+		return fmt.Errorf("validating %s: %w", m.ProtoReflect().Descriptor().FullName(), err)
+	}
+	violations := make([]*errdetails.BadRequest_FieldViolation, len(validationErr.Violations))
+	for j, v := range validationErr.Violations {
+		violations[j] = &errdetails.BadRequest_FieldViolation{
+			Field:       protovalidate.FieldPathString(v.Proto.GetField()),
+			Description: v.Proto.GetMessage(),
+			Reason:      v.Proto.GetRuleId(),
+		}
+	}
+	return apierr.InvalidFields(violations)
+}
+
+// streamingHandlerConn validates each message the client sends.
+type streamingHandlerConn struct {
+	connect.StreamingHandlerConn
+	interceptor *interceptor
+}
+
+func (c *streamingHandlerConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	return c.interceptor.validate(msg)
+}
@@ -0,0 +1,109 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+
+	todov1 "github.com/kogamitora/todo/gen/proto/todo/v1"
+	"github.com/kogamitora/todo/gen/proto/todo/v1/v1connect"
+)
+
+type createTodoHandler struct {
+	v1connect.UnimplementedTodoServiceHandler
+	calls int
+}
+
+func (h *createTodoHandler) CreateTodo(ctx context.Context, req *connect.Request[todov1.CreateTodoRequest]) (*connect.Response[todov1.CreateTodoResponse], error) {
+	h.calls++
+	return connect.NewResponse(&todov1.CreateTodoResponse{Todo: &todov1.Todo{Id: 1, Title: req.Msg.Title}}), nil
+}
+
+func TestInterceptorRejectsInvalidRequests(t *testing.T) {
+	interceptor, err := NewInterceptor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := &createTodoHandler{}
+	mux := http.NewServeMux()
+	mux.Handle(v1connect.NewTodoServiceHandler(handler, connect.WithInterceptors(interceptor)))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	client := v1connect.NewTodoServiceClient(srv.Client(), srv.URL)
+
+	_, err = client.CreateTodo(context.Background(), connect.NewRequest(&todov1.CreateTodoRequest{
+		Description: strings.Repeat("x", 65536),
+	}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("CreateTodo error = %v, want invalid_argument", err)
+	}
+	if handler.calls != 0 {
+		t.Fatal("handler was called for an invalid request")
+	}
+
+	var connectErr *connect.Error
+	errors.As(err, &connectErr)
+	var fields []string
+	for _, d := range connectErr.Details() {
+		value, err := d.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if br, ok := value.(*errdetails.BadRequest); ok {
+			for _, v := range br.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	if strings.Join(fields, ",") != "title,description" {
+		t.Errorf("violated fields = %v, want [title description]", fields)
+	}
+
+	_, err = client.CreateTodo(context.Background(), connect.NewRequest(&todov1.CreateTodoRequest{Title: "write tests"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if handler.calls != 1 {
+		t.Errorf("handler calls = %d, want 1", handler.calls)
+	}
+}
+
+func TestCommentRules(t *testing.T) {
+	i, err := NewInterceptor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator := i.(*interceptor)
+	limit := int32(0)
+	for _, tt := range []struct {
+		name  string
+		msg   proto.Message
+		valid bool
+	}{
+		{"add", &todov1.AddCommentRequest{TodoId: 1, Body: "looks good"}, true},
+		{"add without body", &todov1.AddCommentRequest{TodoId: 1}, false},
+		{"add with long body", &todov1.AddCommentRequest{TodoId: 1, Body: strings.Repeat("x", 65536)}, false},
+		{"add to no todo", &todov1.AddCommentRequest{Body: "looks good"}, false},
+		{"update without body", &todov1.UpdateCommentRequest{Id: 1}, false},
+		{"delete without id", &todov1.DeleteCommentRequest{}, false},
+		{"list", &todov1.ListCommentsRequest{TodoId: 1}, true},
+		{"list with zero limit", &todov1.ListCommentsRequest{TodoId: 1, Limit: &limit}, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.validate(tt.msg)
+			if tt.valid && err != nil {
+				t.Errorf("validate: %v", err)
+			}
+			if !tt.valid && connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Errorf("validate = %v, want invalid_argument", err)
+			}
+		})
+	}
+}
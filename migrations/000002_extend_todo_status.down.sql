@@ -0,0 +1,5 @@
+UPDATE `todos` SET `status` = 'TODO_STATUS_INCOMPLETE'
+    WHERE `status` IN ('TODO_STATUS_IN_PROGRESS', 'TODO_STATUS_BLOCKED', 'TODO_STATUS_CANCELLED');
+ALTER TABLE `todos`
+    MODIFY `status` ENUM('TODO_STATUS_UNSPECIFIED', 'TODO_STATUS_INCOMPLETE', 'TODO_STATUS_COMPLETED') NOT NULL DEFAULT 'TODO_STATUS_INCOMPLETE';
+-- rollback時は追加したステータスを未完了に戻してから ENUM を元に戻します。
@@ -0,0 +1,10 @@
+ALTER TABLE `todos`
+    MODIFY `status` ENUM(
+        'TODO_STATUS_UNSPECIFIED',
+        'TODO_STATUS_INCOMPLETE',
+        'TODO_STATUS_COMPLETED',
+        'TODO_STATUS_IN_PROGRESS',
+        'TODO_STATUS_BLOCKED',
+        'TODO_STATUS_CANCELLED'
+    ) NOT NULL DEFAULT 'TODO_STATUS_INCOMPLETE';
+-- 作業中・ブロック中・キャンセルの各ステータスを追加します。
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `todo_dependencies`;
+--rollback時にここでの操作を実行し、todo_dependencies tableを削除します。
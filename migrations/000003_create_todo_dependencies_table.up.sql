@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS `todo_dependencies` (
+    `todo_id` BIGINT NOT NULL,
+    `blocked_by_id` BIGINT NOT NULL,
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`todo_id`, `blocked_by_id`),
+    KEY `idx_todo_dependencies_blocked_by_id` (`blocked_by_id`),
+    CONSTRAINT `fk_todo_dependencies_todo_id` FOREIGN KEY (`todo_id`) REFERENCES `todos` (`id`),
+    CONSTRAINT `fk_todo_dependencies_blocked_by_id` FOREIGN KEY (`blocked_by_id`) REFERENCES `todos` (`id`)
+) ENGINE=InnoDB;
+-- todo_id のタスクは blocked_by_id のタスクが終わるまで着手できないことを表します。
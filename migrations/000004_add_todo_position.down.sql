@@ -0,0 +1,3 @@
+DROP INDEX `idx_todos_status_position` ON `todos`;
+ALTER TABLE `todos` DROP COLUMN `position`;
+--rollback時にここでの操作を実行し、position列を削除します。
@@ -0,0 +1,5 @@
+ALTER TABLE `todos`
+    ADD COLUMN `position` VARCHAR(255) CHARACTER SET ascii COLLATE ascii_bin NOT NULL DEFAULT '' AFTER `status`;
+UPDATE `todos` SET `position` = CONCAT(LPAD(`id`, 12, '0'), 'V');
+CREATE INDEX `idx_todos_status_position` ON `todos` (`status`, `position`);
+-- 手動並び替え用のソートキーです。既存の行は作成順 (id) で初期化します。
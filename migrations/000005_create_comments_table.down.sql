@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `comments`;
+--rollback時にここでの操作を実行し、comments tableを削除します。
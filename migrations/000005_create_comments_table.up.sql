@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS `comments` (
+    `id` BIGINT AUTO_INCREMENT PRIMARY KEY,
+    `todo_id` BIGINT NOT NULL,
+    `author` VARCHAR(255) NOT NULL,
+    `body` TEXT NOT NULL,
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    `deleted_at` TIMESTAMP NULL,
+    KEY `idx_comments_todo_id_created_at` (`todo_id`, `created_at`),
+    CONSTRAINT `fk_comments_todo_id` FOREIGN KEY (`todo_id`) REFERENCES `todos` (`id`)
+) ENGINE=InnoDB;
+-- todo ごとのコメントです。todos と同様に deleted_at で論理削除します。
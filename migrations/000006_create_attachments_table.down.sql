@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `attachments`;
+--rollback時にここでの操作を実行し、attachments tableを削除します。
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `attachments` (
+    `id` BIGINT AUTO_INCREMENT PRIMARY KEY,
+    `todo_id` BIGINT NOT NULL,
+    `filename` VARCHAR(255) NOT NULL,
+    `content_type` VARCHAR(255) NOT NULL,
+    `size` BIGINT NOT NULL,
+    `sha256` CHAR(64) NOT NULL,
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `deleted_at` TIMESTAMP NULL,
+    KEY `idx_attachments_todo_id` (`todo_id`),
+    KEY `idx_attachments_sha256` (`sha256`),
+    CONSTRAINT `fk_attachments_todo_id` FOREIGN KEY (`todo_id`) REFERENCES `todos` (`id`)
+) ENGINE=InnoDB;
+-- 添付ファイルのメタデータです。中身はストレージに sha256 をキーとして保存し、同じ内容は共有します。
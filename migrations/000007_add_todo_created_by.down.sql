@@ -0,0 +1,3 @@
+DROP INDEX `idx_todos_created_by` ON `todos`;
+ALTER TABLE `todos` DROP COLUMN `created_by`;
+--rollback時にここでの操作を実行し、created_by列を削除します。
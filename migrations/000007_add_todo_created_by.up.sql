@@ -0,0 +1,4 @@
+ALTER TABLE `todos`
+    ADD COLUMN `created_by` VARCHAR(255) NULL AFTER `position`;
+CREATE INDEX `idx_todos_created_by` ON `todos` (`created_by`);
+-- 作成したユーザー (X-User ヘッダー) です。ユーザーごとの TODO 件数の上限に使います。
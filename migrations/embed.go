@@ -0,0 +1,8 @@
+// Package migrations embeds the golang-migrate files, so that the server
+// binary can apply the schema it was built for.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS attachments;
+DROP TABLE IF EXISTS comments;
+DROP TABLE IF EXISTS todo_dependencies;
+DROP TABLE IF EXISTS todos;
+DROP TYPE IF EXISTS todo_status;
+--rollback時にここでの操作を実行し、すべての table と型を削除します。
@@ -0,0 +1,62 @@
+CREATE TYPE todo_status AS ENUM (
+    'TODO_STATUS_UNSPECIFIED',
+    'TODO_STATUS_INCOMPLETE',
+    'TODO_STATUS_COMPLETED',
+    'TODO_STATUS_IN_PROGRESS',
+    'TODO_STATUS_BLOCKED',
+    'TODO_STATUS_CANCELLED'
+);
+
+CREATE TABLE IF NOT EXISTS todos (
+    id BIGSERIAL PRIMARY KEY,
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    due_date TIMESTAMPTZ NULL,
+    status todo_status NOT NULL DEFAULT 'TODO_STATUS_INCOMPLETE',
+    position VARCHAR(255) COLLATE "C" NOT NULL DEFAULT '',
+    created_by VARCHAR(255) NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ NULL
+);
+CREATE INDEX idx_todos_status_position ON todos (status, position);
+CREATE INDEX idx_todos_created_by ON todos (created_by);
+
+CREATE TABLE IF NOT EXISTS todo_dependencies (
+    todo_id BIGINT NOT NULL,
+    blocked_by_id BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (todo_id, blocked_by_id),
+    CONSTRAINT fk_todo_dependencies_todo_id FOREIGN KEY (todo_id) REFERENCES todos (id),
+    CONSTRAINT fk_todo_dependencies_blocked_by_id FOREIGN KEY (blocked_by_id) REFERENCES todos (id)
+);
+CREATE INDEX idx_todo_dependencies_blocked_by_id ON todo_dependencies (blocked_by_id);
+
+CREATE TABLE IF NOT EXISTS comments (
+    id BIGSERIAL PRIMARY KEY,
+    todo_id BIGINT NOT NULL,
+    author VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ NULL,
+    CONSTRAINT fk_comments_todo_id FOREIGN KEY (todo_id) REFERENCES todos (id)
+);
+CREATE INDEX idx_comments_todo_id_created_at ON comments (todo_id, created_at);
+
+CREATE TABLE IF NOT EXISTS attachments (
+    id BIGSERIAL PRIMARY KEY,
+    todo_id BIGINT NOT NULL,
+    filename VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    sha256 CHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ NULL,
+    CONSTRAINT fk_attachments_todo_id FOREIGN KEY (todo_id) REFERENCES todos (id)
+);
+CREATE INDEX idx_attachments_todo_id ON attachments (todo_id);
+CREATE INDEX idx_attachments_sha256 ON attachments (sha256);
+-- MySQL の 000001〜000007 と同じスキーマです。status は PostgreSQL の ENUM 型で定義し、
+-- position はバイト順で比較されるよう COLLATE "C" を指定します。
+-- ON UPDATE がないため、updated_at はアプリケーション (sqlboiler) が更新します。
@@ -0,0 +1,9 @@
+// Package postgres embeds the golang-migrate files of the PostgreSQL
+// backend. They create the same tables and columns as the MySQL migrations;
+// the models in pgmodels are generated from them.
+package postgres
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS `attachments`;
+DROP TABLE IF EXISTS `comments`;
+DROP TABLE IF EXISTS `todo_dependencies`;
+DROP TABLE IF EXISTS `todos`;
+--rollback時にここでの操作を実行し、すべての table を削除します。
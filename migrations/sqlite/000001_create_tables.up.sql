@@ -0,0 +1,56 @@
+CREATE TABLE IF NOT EXISTS `todos` (
+    `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+    `title` VARCHAR(255) NOT NULL,
+    `description` TEXT,
+    `due_date` TIMESTAMP NULL,
+    `status` TEXT NOT NULL DEFAULT 'TODO_STATUS_INCOMPLETE' CHECK (`status` IN (
+        'TODO_STATUS_UNSPECIFIED',
+        'TODO_STATUS_INCOMPLETE',
+        'TODO_STATUS_COMPLETED',
+        'TODO_STATUS_IN_PROGRESS',
+        'TODO_STATUS_BLOCKED',
+        'TODO_STATUS_CANCELLED'
+    )),
+    `position` TEXT NOT NULL DEFAULT '',
+    `created_by` VARCHAR(255) NULL,
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `deleted_at` TIMESTAMP NULL
+);
+CREATE INDEX `idx_todos_status_position` ON `todos` (`status`, `position`);
+CREATE INDEX `idx_todos_created_by` ON `todos` (`created_by`);
+
+CREATE TABLE IF NOT EXISTS `todo_dependencies` (
+    `todo_id` INTEGER NOT NULL REFERENCES `todos` (`id`),
+    `blocked_by_id` INTEGER NOT NULL REFERENCES `todos` (`id`),
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`todo_id`, `blocked_by_id`)
+);
+CREATE INDEX `idx_todo_dependencies_blocked_by_id` ON `todo_dependencies` (`blocked_by_id`);
+
+CREATE TABLE IF NOT EXISTS `comments` (
+    `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+    `todo_id` INTEGER NOT NULL REFERENCES `todos` (`id`),
+    `author` VARCHAR(255) NOT NULL,
+    `body` TEXT NOT NULL,
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updated_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `deleted_at` TIMESTAMP NULL
+);
+CREATE INDEX `idx_comments_todo_id_created_at` ON `comments` (`todo_id`, `created_at`);
+
+CREATE TABLE IF NOT EXISTS `attachments` (
+    `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+    `todo_id` INTEGER NOT NULL REFERENCES `todos` (`id`),
+    `filename` VARCHAR(255) NOT NULL,
+    `content_type` VARCHAR(255) NOT NULL,
+    `size` INTEGER NOT NULL,
+    `sha256` CHAR(64) NOT NULL,
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `deleted_at` TIMESTAMP NULL
+);
+CREATE INDEX `idx_attachments_todo_id` ON `attachments` (`todo_id`);
+CREATE INDEX `idx_attachments_sha256` ON `attachments` (`sha256`);
+-- MySQL の 000001〜000007 と同じスキーマです。SQLite には ENUM と ON UPDATE がないため、
+-- status は CHECK 制約で制限し、updated_at はアプリケーションが更新します。
+-- 日時は UTC の文字列で保存し、文字列の順序が時刻の順序と一致するようにします。
@@ -0,0 +1,8 @@
+// Package sqlite embeds the golang-migrate files of the SQLite backend.
+// They create the same tables and columns as the MySQL migrations.
+package sqlite
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -7,7 +7,12 @@ import "testing"
 
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOne(t *testing.T) {}
+func TestToOne(t *testing.T) {
+	t.Run("AttachmentToTodoUsingTodo", testAttachmentToOneTodoUsingTodo)
+	t.Run("CommentToTodoUsingTodo", testCommentToOneTodoUsingTodo)
+	t.Run("TodoDependencyToTodoUsingBlockedBy", testTodoDependencyToOneTodoUsingBlockedBy)
+	t.Run("TodoDependencyToTodoUsingTodo", testTodoDependencyToOneTodoUsingTodo)
+}
 
 // TestOneToOne tests cannot be run in parallel
 // or deadlocks can occur.
@@ -15,11 +20,21 @@ func TestOneToOne(t *testing.T) {}
 
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToMany(t *testing.T) {}
+func TestToMany(t *testing.T) {
+	t.Run("TodoToAttachments", testTodoToManyAttachments)
+	t.Run("TodoToComments", testTodoToManyComments)
+	t.Run("TodoToBlockedByTodoDependencies", testTodoToManyBlockedByTodoDependencies)
+	t.Run("TodoToTodoDependencies", testTodoToManyTodoDependencies)
+}
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneSet(t *testing.T) {}
+func TestToOneSet(t *testing.T) {
+	t.Run("AttachmentToTodoUsingAttachments", testAttachmentToOneSetOpTodoUsingTodo)
+	t.Run("CommentToTodoUsingComments", testCommentToOneSetOpTodoUsingTodo)
+	t.Run("TodoDependencyToTodoUsingBlockedByTodoDependencies", testTodoDependencyToOneSetOpTodoUsingBlockedBy)
+	t.Run("TodoDependencyToTodoUsingTodoDependencies", testTodoDependencyToOneSetOpTodoUsingTodo)
+}
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
@@ -35,7 +50,12 @@ func TestOneToOneRemove(t *testing.T) {}
 
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyAdd(t *testing.T) {}
+func TestToManyAdd(t *testing.T) {
+	t.Run("TodoToAttachments", testTodoToManyAddOpAttachments)
+	t.Run("TodoToComments", testTodoToManyAddOpComments)
+	t.Run("TodoToBlockedByTodoDependencies", testTodoToManyAddOpBlockedByTodoDependencies)
+	t.Run("TodoToTodoDependencies", testTodoToManyAddOpTodoDependencies)
+}
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
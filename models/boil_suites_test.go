@@ -12,70 +12,124 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("Attachments", testAttachments)
+	t.Run("Comments", testComments)
+	t.Run("TodoDependencies", testTodoDependencies)
 	t.Run("Todos", testTodos)
 }
 
 func TestDelete(t *testing.T) {
+	t.Run("Attachments", testAttachmentsDelete)
+	t.Run("Comments", testCommentsDelete)
+	t.Run("TodoDependencies", testTodoDependenciesDelete)
 	t.Run("Todos", testTodosDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("Attachments", testAttachmentsQueryDeleteAll)
+	t.Run("Comments", testCommentsQueryDeleteAll)
+	t.Run("TodoDependencies", testTodoDependenciesQueryDeleteAll)
 	t.Run("Todos", testTodosQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("Attachments", testAttachmentsSliceDeleteAll)
+	t.Run("Comments", testCommentsSliceDeleteAll)
+	t.Run("TodoDependencies", testTodoDependenciesSliceDeleteAll)
 	t.Run("Todos", testTodosSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("Attachments", testAttachmentsExists)
+	t.Run("Comments", testCommentsExists)
+	t.Run("TodoDependencies", testTodoDependenciesExists)
 	t.Run("Todos", testTodosExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("Attachments", testAttachmentsFind)
+	t.Run("Comments", testCommentsFind)
+	t.Run("TodoDependencies", testTodoDependenciesFind)
 	t.Run("Todos", testTodosFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("Attachments", testAttachmentsBind)
+	t.Run("Comments", testCommentsBind)
+	t.Run("TodoDependencies", testTodoDependenciesBind)
 	t.Run("Todos", testTodosBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("Attachments", testAttachmentsOne)
+	t.Run("Comments", testCommentsOne)
+	t.Run("TodoDependencies", testTodoDependenciesOne)
 	t.Run("Todos", testTodosOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("Attachments", testAttachmentsAll)
+	t.Run("Comments", testCommentsAll)
+	t.Run("TodoDependencies", testTodoDependenciesAll)
 	t.Run("Todos", testTodosAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("Attachments", testAttachmentsCount)
+	t.Run("Comments", testCommentsCount)
+	t.Run("TodoDependencies", testTodoDependenciesCount)
 	t.Run("Todos", testTodosCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("Attachments", testAttachmentsHooks)
+	t.Run("Comments", testCommentsHooks)
+	t.Run("TodoDependencies", testTodoDependenciesHooks)
 	t.Run("Todos", testTodosHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("Attachments", testAttachmentsInsert)
+	t.Run("Attachments", testAttachmentsInsertWhitelist)
+	t.Run("Comments", testCommentsInsert)
+	t.Run("Comments", testCommentsInsertWhitelist)
+	t.Run("TodoDependencies", testTodoDependenciesInsert)
+	t.Run("TodoDependencies", testTodoDependenciesInsertWhitelist)
 	t.Run("Todos", testTodosInsert)
 	t.Run("Todos", testTodosInsertWhitelist)
 }
 
 func TestReload(t *testing.T) {
+	t.Run("Attachments", testAttachmentsReload)
+	t.Run("Comments", testCommentsReload)
+	t.Run("TodoDependencies", testTodoDependenciesReload)
 	t.Run("Todos", testTodosReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("Attachments", testAttachmentsReloadAll)
+	t.Run("Comments", testCommentsReloadAll)
+	t.Run("TodoDependencies", testTodoDependenciesReloadAll)
 	t.Run("Todos", testTodosReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("Attachments", testAttachmentsSelect)
+	t.Run("Comments", testCommentsSelect)
+	t.Run("TodoDependencies", testTodoDependenciesSelect)
 	t.Run("Todos", testTodosSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("Attachments", testAttachmentsUpdate)
+	t.Run("Comments", testCommentsUpdate)
+	t.Run("TodoDependencies", testTodoDependenciesUpdate)
 	t.Run("Todos", testTodosUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("Attachments", testAttachmentsSliceUpdateAll)
+	t.Run("Comments", testCommentsSliceUpdateAll)
+	t.Run("TodoDependencies", testTodoDependenciesSliceUpdateAll)
 	t.Run("Todos", testTodosSliceUpdateAll)
 }
@@ -4,7 +4,13 @@
 package models
 
 var TableNames = struct {
-	Todos string
+	Attachments      string
+	Comments         string
+	TodoDependencies string
+	Todos            string
 }{
-	Todos: "todos",
+	Attachments:      "attachments",
+	Comments:         "comments",
+	TodoDependencies: "todo_dependencies",
+	Todos:            "todos",
 }
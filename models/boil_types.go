@@ -56,6 +56,9 @@ const (
 	TodosStatusTODO_STATUS_UNSPECIFIED string = "TODO_STATUS_UNSPECIFIED"
 	TodosStatusTODO_STATUS_INCOMPLETE  string = "TODO_STATUS_INCOMPLETE"
 	TodosStatusTODO_STATUS_COMPLETED   string = "TODO_STATUS_COMPLETED"
+	TodosStatusTODO_STATUS_IN_PROGRESS string = "TODO_STATUS_IN_PROGRESS"
+	TodosStatusTODO_STATUS_BLOCKED     string = "TODO_STATUS_BLOCKED"
+	TodosStatusTODO_STATUS_CANCELLED   string = "TODO_STATUS_CANCELLED"
 )
 
 func AllTodosStatus() []string {
@@ -63,5 +66,8 @@ func AllTodosStatus() []string {
 		TodosStatusTODO_STATUS_UNSPECIFIED,
 		TodosStatusTODO_STATUS_INCOMPLETE,
 		TodosStatusTODO_STATUS_COMPLETED,
+		TodosStatusTODO_STATUS_IN_PROGRESS,
+		TodosStatusTODO_STATUS_BLOCKED,
+		TodosStatusTODO_STATUS_CANCELLED,
 	}
 }
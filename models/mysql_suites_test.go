@@ -6,5 +6,11 @@ package models
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("Attachments", testAttachmentsUpsert)
+
+	t.Run("Comments", testCommentsUpsert)
+
+	t.Run("TodoDependencies", testTodoDependenciesUpsert)
+
 	t.Run("Todos", testTodosUpsert)
 }
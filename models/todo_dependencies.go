@@ -0,0 +1,1376 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TodoDependency is an object representing the database table.
+type TodoDependency struct {
+	TodoID      int64     `boil:"todo_id" json:"todo_id" toml:"todo_id" yaml:"todo_id"`
+	BlockedByID int64     `boil:"blocked_by_id" json:"blocked_by_id" toml:"blocked_by_id" yaml:"blocked_by_id"`
+	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *todoDependencyR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L todoDependencyL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TodoDependencyColumns = struct {
+	TodoID      string
+	BlockedByID string
+	CreatedAt   string
+}{
+	TodoID:      "todo_id",
+	BlockedByID: "blocked_by_id",
+	CreatedAt:   "created_at",
+}
+
+var TodoDependencyTableColumns = struct {
+	TodoID      string
+	BlockedByID string
+	CreatedAt   string
+}{
+	TodoID:      "todo_dependencies.todo_id",
+	BlockedByID: "todo_dependencies.blocked_by_id",
+	CreatedAt:   "todo_dependencies.created_at",
+}
+
+// Generated where
+
+var TodoDependencyWhere = struct {
+	TodoID      whereHelperint64
+	BlockedByID whereHelperint64
+	CreatedAt   whereHelpertime_Time
+}{
+	TodoID:      whereHelperint64{field: "`todo_dependencies`.`todo_id`"},
+	BlockedByID: whereHelperint64{field: "`todo_dependencies`.`blocked_by_id`"},
+	CreatedAt:   whereHelpertime_Time{field: "`todo_dependencies`.`created_at`"},
+}
+
+// TodoDependencyRels is where relationship names are stored.
+var TodoDependencyRels = struct {
+	BlockedBy string
+	Todo      string
+}{
+	BlockedBy: "BlockedBy",
+	Todo:      "Todo",
+}
+
+// todoDependencyR is where relationships are stored.
+type todoDependencyR struct {
+	BlockedBy *Todo `boil:"BlockedBy" json:"BlockedBy" toml:"BlockedBy" yaml:"BlockedBy"`
+	Todo      *Todo `boil:"Todo" json:"Todo" toml:"Todo" yaml:"Todo"`
+}
+
+// NewStruct creates a new relationship struct
+func (*todoDependencyR) NewStruct() *todoDependencyR {
+	return &todoDependencyR{}
+}
+
+func (o *TodoDependency) GetBlockedBy() *Todo {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBlockedBy()
+}
+
+func (r *todoDependencyR) GetBlockedBy() *Todo {
+	if r == nil {
+		return nil
+	}
+
+	return r.BlockedBy
+}
+
+func (o *TodoDependency) GetTodo() *Todo {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTodo()
+}
+
+func (r *todoDependencyR) GetTodo() *Todo {
+	if r == nil {
+		return nil
+	}
+
+	return r.Todo
+}
+
+// todoDependencyL is where Load methods for each relationship are stored.
+type todoDependencyL struct{}
+
+var (
+	todoDependencyAllColumns            = []string{"todo_id", "blocked_by_id", "created_at"}
+	todoDependencyColumnsWithoutDefault = []string{"todo_id", "blocked_by_id"}
+	todoDependencyColumnsWithDefault    = []string{"created_at"}
+	todoDependencyPrimaryKeyColumns     = []string{"todo_id", "blocked_by_id"}
+	todoDependencyGeneratedColumns      = []string{}
+)
+
+type (
+	// TodoDependencySlice is an alias for a slice of pointers to TodoDependency.
+	// This should almost always be used instead of []TodoDependency.
+	TodoDependencySlice []*TodoDependency
+	// TodoDependencyHook is the signature for custom TodoDependency hook methods
+	TodoDependencyHook func(context.Context, boil.ContextExecutor, *TodoDependency) error
+
+	todoDependencyQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	todoDependencyType                 = reflect.TypeOf(&TodoDependency{})
+	todoDependencyMapping              = queries.MakeStructMapping(todoDependencyType)
+	todoDependencyPrimaryKeyMapping, _ = queries.BindMapping(todoDependencyType, todoDependencyMapping, todoDependencyPrimaryKeyColumns)
+	todoDependencyInsertCacheMut       sync.RWMutex
+	todoDependencyInsertCache          = make(map[string]insertCache)
+	todoDependencyUpdateCacheMut       sync.RWMutex
+	todoDependencyUpdateCache          = make(map[string]updateCache)
+	todoDependencyUpsertCacheMut       sync.RWMutex
+	todoDependencyUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var todoDependencyAfterSelectMu sync.Mutex
+var todoDependencyAfterSelectHooks []TodoDependencyHook
+
+var todoDependencyBeforeInsertMu sync.Mutex
+var todoDependencyBeforeInsertHooks []TodoDependencyHook
+var todoDependencyAfterInsertMu sync.Mutex
+var todoDependencyAfterInsertHooks []TodoDependencyHook
+
+var todoDependencyBeforeUpdateMu sync.Mutex
+var todoDependencyBeforeUpdateHooks []TodoDependencyHook
+var todoDependencyAfterUpdateMu sync.Mutex
+var todoDependencyAfterUpdateHooks []TodoDependencyHook
+
+var todoDependencyBeforeDeleteMu sync.Mutex
+var todoDependencyBeforeDeleteHooks []TodoDependencyHook
+var todoDependencyAfterDeleteMu sync.Mutex
+var todoDependencyAfterDeleteHooks []TodoDependencyHook
+
+var todoDependencyBeforeUpsertMu sync.Mutex
+var todoDependencyBeforeUpsertHooks []TodoDependencyHook
+var todoDependencyAfterUpsertMu sync.Mutex
+var todoDependencyAfterUpsertHooks []TodoDependencyHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TodoDependency) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoDependencyAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TodoDependency) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoDependencyBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TodoDependency) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoDependencyAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TodoDependency) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoDependencyBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TodoDependency) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoDependencyAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TodoDependency) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoDependencyBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TodoDependency) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoDependencyAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TodoDependency) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoDependencyBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TodoDependency) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoDependencyAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTodoDependencyHook registers your hook function for all future operations.
+func AddTodoDependencyHook(hookPoint boil.HookPoint, todoDependencyHook TodoDependencyHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		todoDependencyAfterSelectMu.Lock()
+		todoDependencyAfterSelectHooks = append(todoDependencyAfterSelectHooks, todoDependencyHook)
+		todoDependencyAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		todoDependencyBeforeInsertMu.Lock()
+		todoDependencyBeforeInsertHooks = append(todoDependencyBeforeInsertHooks, todoDependencyHook)
+		todoDependencyBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		todoDependencyAfterInsertMu.Lock()
+		todoDependencyAfterInsertHooks = append(todoDependencyAfterInsertHooks, todoDependencyHook)
+		todoDependencyAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		todoDependencyBeforeUpdateMu.Lock()
+		todoDependencyBeforeUpdateHooks = append(todoDependencyBeforeUpdateHooks, todoDependencyHook)
+		todoDependencyBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		todoDependencyAfterUpdateMu.Lock()
+		todoDependencyAfterUpdateHooks = append(todoDependencyAfterUpdateHooks, todoDependencyHook)
+		todoDependencyAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		todoDependencyBeforeDeleteMu.Lock()
+		todoDependencyBeforeDeleteHooks = append(todoDependencyBeforeDeleteHooks, todoDependencyHook)
+		todoDependencyBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		todoDependencyAfterDeleteMu.Lock()
+		todoDependencyAfterDeleteHooks = append(todoDependencyAfterDeleteHooks, todoDependencyHook)
+		todoDependencyAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		todoDependencyBeforeUpsertMu.Lock()
+		todoDependencyBeforeUpsertHooks = append(todoDependencyBeforeUpsertHooks, todoDependencyHook)
+		todoDependencyBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		todoDependencyAfterUpsertMu.Lock()
+		todoDependencyAfterUpsertHooks = append(todoDependencyAfterUpsertHooks, todoDependencyHook)
+		todoDependencyAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single todoDependency record from the query.
+func (q todoDependencyQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TodoDependency, error) {
+	o := &TodoDependency{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for todo_dependencies")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TodoDependency records from the query.
+func (q todoDependencyQuery) All(ctx context.Context, exec boil.ContextExecutor) (TodoDependencySlice, error) {
+	var o []*TodoDependency
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to TodoDependency slice")
+	}
+
+	if len(todoDependencyAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TodoDependency records in the query.
+func (q todoDependencyQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count todo_dependencies rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q todoDependencyQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if todo_dependencies exists")
+	}
+
+	return count > 0, nil
+}
+
+// BlockedBy pointed to by the foreign key.
+func (o *TodoDependency) BlockedBy(mods ...qm.QueryMod) todoQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.BlockedByID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Todos(queryMods...)
+}
+
+// Todo pointed to by the foreign key.
+func (o *TodoDependency) Todo(mods ...qm.QueryMod) todoQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.TodoID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Todos(queryMods...)
+}
+
+// LoadBlockedBy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (todoDependencyL) LoadBlockedBy(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodoDependency interface{}, mods queries.Applicator) error {
+	var slice []*TodoDependency
+	var object *TodoDependency
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodoDependency.(*TodoDependency)
+		if !ok {
+			object = new(TodoDependency)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodoDependency)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodoDependency))
+			}
+		}
+	} else {
+		s, ok := maybeTodoDependency.(*[]*TodoDependency)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodoDependency)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodoDependency))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoDependencyR{}
+		}
+		args[object.BlockedByID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoDependencyR{}
+			}
+
+			args[obj.BlockedByID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todos`),
+		qm.WhereIn(`todos.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Todo")
+	}
+
+	var resultSlice []*Todo
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Todo")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for todos")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todos")
+	}
+
+	if len(todoAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.BlockedBy = foreign
+		if foreign.R == nil {
+			foreign.R = &todoR{}
+		}
+		foreign.R.BlockedByTodoDependencies = append(foreign.R.BlockedByTodoDependencies, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.BlockedByID == foreign.ID {
+				local.R.BlockedBy = foreign
+				if foreign.R == nil {
+					foreign.R = &todoR{}
+				}
+				foreign.R.BlockedByTodoDependencies = append(foreign.R.BlockedByTodoDependencies, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTodo allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (todoDependencyL) LoadTodo(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodoDependency interface{}, mods queries.Applicator) error {
+	var slice []*TodoDependency
+	var object *TodoDependency
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodoDependency.(*TodoDependency)
+		if !ok {
+			object = new(TodoDependency)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodoDependency)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodoDependency))
+			}
+		}
+	} else {
+		s, ok := maybeTodoDependency.(*[]*TodoDependency)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodoDependency)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodoDependency))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoDependencyR{}
+		}
+		args[object.TodoID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoDependencyR{}
+			}
+
+			args[obj.TodoID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todos`),
+		qm.WhereIn(`todos.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Todo")
+	}
+
+	var resultSlice []*Todo
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Todo")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for todos")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todos")
+	}
+
+	if len(todoAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Todo = foreign
+		if foreign.R == nil {
+			foreign.R = &todoR{}
+		}
+		foreign.R.TodoDependencies = append(foreign.R.TodoDependencies, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TodoID == foreign.ID {
+				local.R.Todo = foreign
+				if foreign.R == nil {
+					foreign.R = &todoR{}
+				}
+				foreign.R.TodoDependencies = append(foreign.R.TodoDependencies, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBlockedBy of the todoDependency to the related item.
+// Sets o.R.BlockedBy to related.
+// Adds o to related.R.BlockedByTodoDependencies.
+func (o *TodoDependency) SetBlockedBy(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Todo) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `todo_dependencies` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"blocked_by_id"}),
+		strmangle.WhereClause("`", "`", 0, todoDependencyPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.TodoID, o.BlockedByID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.BlockedByID = related.ID
+	if o.R == nil {
+		o.R = &todoDependencyR{
+			BlockedBy: related,
+		}
+	} else {
+		o.R.BlockedBy = related
+	}
+
+	if related.R == nil {
+		related.R = &todoR{
+			BlockedByTodoDependencies: TodoDependencySlice{o},
+		}
+	} else {
+		related.R.BlockedByTodoDependencies = append(related.R.BlockedByTodoDependencies, o)
+	}
+
+	return nil
+}
+
+// SetTodo of the todoDependency to the related item.
+// Sets o.R.Todo to related.
+// Adds o to related.R.TodoDependencies.
+func (o *TodoDependency) SetTodo(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Todo) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `todo_dependencies` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"todo_id"}),
+		strmangle.WhereClause("`", "`", 0, todoDependencyPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.TodoID, o.BlockedByID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TodoID = related.ID
+	if o.R == nil {
+		o.R = &todoDependencyR{
+			Todo: related,
+		}
+	} else {
+		o.R.Todo = related
+	}
+
+	if related.R == nil {
+		related.R = &todoR{
+			TodoDependencies: TodoDependencySlice{o},
+		}
+	} else {
+		related.R.TodoDependencies = append(related.R.TodoDependencies, o)
+	}
+
+	return nil
+}
+
+// TodoDependencies retrieves all the records using an executor.
+func TodoDependencies(mods ...qm.QueryMod) todoDependencyQuery {
+	mods = append(mods, qm.From("`todo_dependencies`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`todo_dependencies`.*"})
+	}
+
+	return todoDependencyQuery{q}
+}
+
+// FindTodoDependency retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTodoDependency(ctx context.Context, exec boil.ContextExecutor, todoID int64, blockedByID int64, selectCols ...string) (*TodoDependency, error) {
+	todoDependencyObj := &TodoDependency{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `todo_dependencies` where `todo_id`=? AND `blocked_by_id`=?", sel,
+	)
+
+	q := queries.Raw(query, todoID, blockedByID)
+
+	err := q.Bind(ctx, exec, todoDependencyObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from todo_dependencies")
+	}
+
+	if err = todoDependencyObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return todoDependencyObj, err
+	}
+
+	return todoDependencyObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TodoDependency) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no todo_dependencies provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(todoDependencyColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	todoDependencyInsertCacheMut.RLock()
+	cache, cached := todoDependencyInsertCache[key]
+	todoDependencyInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			todoDependencyAllColumns,
+			todoDependencyColumnsWithDefault,
+			todoDependencyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(todoDependencyType, todoDependencyMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(todoDependencyType, todoDependencyMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `todo_dependencies` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `todo_dependencies` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `todo_dependencies` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, todoDependencyPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into todo_dependencies")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.TodoID,
+		o.BlockedByID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for todo_dependencies")
+	}
+
+CacheNoHooks:
+	if !cached {
+		todoDependencyInsertCacheMut.Lock()
+		todoDependencyInsertCache[key] = cache
+		todoDependencyInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TodoDependency.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TodoDependency) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	todoDependencyUpdateCacheMut.RLock()
+	cache, cached := todoDependencyUpdateCache[key]
+	todoDependencyUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			todoDependencyAllColumns,
+			todoDependencyPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update todo_dependencies, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `todo_dependencies` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, todoDependencyPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(todoDependencyType, todoDependencyMapping, append(wl, todoDependencyPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update todo_dependencies row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for todo_dependencies")
+	}
+
+	if !cached {
+		todoDependencyUpdateCacheMut.Lock()
+		todoDependencyUpdateCache[key] = cache
+		todoDependencyUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q todoDependencyQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for todo_dependencies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for todo_dependencies")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TodoDependencySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoDependencyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `todo_dependencies` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, todoDependencyPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in todoDependency slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all todoDependency")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTodoDependencyUniqueColumns = []string{}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TodoDependency) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no todo_dependencies provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(todoDependencyColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTodoDependencyUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	todoDependencyUpsertCacheMut.RLock()
+	cache, cached := todoDependencyUpsertCache[key]
+	todoDependencyUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			todoDependencyAllColumns,
+			todoDependencyColumnsWithDefault,
+			todoDependencyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			todoDependencyAllColumns,
+			todoDependencyPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert todo_dependencies, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(todoDependencyAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`todo_dependencies`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `todo_dependencies` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(todoDependencyType, todoDependencyMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(todoDependencyType, todoDependencyMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for todo_dependencies")
+	}
+
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(todoDependencyType, todoDependencyMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for todo_dependencies")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for todo_dependencies")
+	}
+
+CacheNoHooks:
+	if !cached {
+		todoDependencyUpsertCacheMut.Lock()
+		todoDependencyUpsertCache[key] = cache
+		todoDependencyUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TodoDependency record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TodoDependency) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no TodoDependency provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), todoDependencyPrimaryKeyMapping)
+	sql := "DELETE FROM `todo_dependencies` WHERE `todo_id`=? AND `blocked_by_id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from todo_dependencies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for todo_dependencies")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q todoDependencyQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no todoDependencyQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from todo_dependencies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for todo_dependencies")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TodoDependencySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(todoDependencyBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoDependencyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `todo_dependencies` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, todoDependencyPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from todoDependency slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for todo_dependencies")
+	}
+
+	if len(todoDependencyAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TodoDependency) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTodoDependency(ctx, exec, o.TodoID, o.BlockedByID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TodoDependencySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TodoDependencySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoDependencyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `todo_dependencies`.* FROM `todo_dependencies` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, todoDependencyPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in TodoDependencySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TodoDependencyExists checks if the TodoDependency row exists.
+func TodoDependencyExists(ctx context.Context, exec boil.ContextExecutor, todoID int64, blockedByID int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `todo_dependencies` where `todo_id`=? AND `blocked_by_id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, todoID, blockedByID)
+	}
+	row := exec.QueryRowContext(ctx, sql, todoID, blockedByID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if todo_dependencies exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TodoDependency row exists.
+func (o *TodoDependency) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TodoDependencyExists(ctx, exec, o.TodoID, o.BlockedByID)
+}
@@ -0,0 +1,964 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aarondl/randomize"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testTodoDependencies(t *testing.T) {
+	t.Parallel()
+
+	query := TodoDependencies()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testTodoDependenciesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TodoDependency{}
+	if err = randomize.Struct(seed, o, todoDependencyDBTypes, true, todoDependencyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TodoDependencies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTodoDependenciesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TodoDependency{}
+	if err = randomize.Struct(seed, o, todoDependencyDBTypes, true, todoDependencyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := TodoDependencies().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TodoDependencies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTodoDependenciesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TodoDependency{}
+	if err = randomize.Struct(seed, o, todoDependencyDBTypes, true, todoDependencyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TodoDependencySlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TodoDependencies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTodoDependenciesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TodoDependency{}
+	if err = randomize.Struct(seed, o, todoDependencyDBTypes, true, todoDependencyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := TodoDependencyExists(ctx, tx, o.TodoID, o.BlockedByID)
+	if err != nil {
+		t.Errorf("Unable to check if TodoDependency exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected TodoDependencyExists to return true, but got false.")
+	}
+}
+
+func testTodoDependenciesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TodoDependency{}
+	if err = randomize.Struct(seed, o, todoDependencyDBTypes, true, todoDependencyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	todoDependencyFound, err := FindTodoDependency(ctx, tx, o.TodoID, o.BlockedByID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if todoDependencyFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testTodoDependenciesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TodoDependency{}
+	if err = randomize.Struct(seed, o, todoDependencyDBTypes, true, todoDependencyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = TodoDependencies().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTodoDependenciesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TodoDependency{}
+	if err = randomize.Struct(seed, o, todoDependencyDBTypes, true, todoDependencyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := TodoDependencies().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testTodoDependenciesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	todoDependencyOne := &TodoDependency{}
+	todoDependencyTwo := &TodoDependency{}
+	if err = randomize.Struct(seed, todoDependencyOne, todoDependencyDBTypes, false, todoDependencyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+	if err = randomize.Struct(seed, todoDependencyTwo, todoDependencyDBTypes, false, todoDependencyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = todoDependencyOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = todoDependencyTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TodoDependencies().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testTodoDependenciesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	todoDependencyOne := &TodoDependency{}
+	todoDependencyTwo := &TodoDependency{}
+	if err = randomize.Struct(seed, todoDependencyOne, todoDependencyDBTypes, false, todoDependencyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+	if err = randomize.Struct(seed, todoDependencyTwo, todoDependencyDBTypes, false, todoDependencyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = todoDependencyOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = todoDependencyTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TodoDependencies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func todoDependencyBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *TodoDependency) error {
+	*o = TodoDependency{}
+	return nil
+}
+
+func todoDependencyAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *TodoDependency) error {
+	*o = TodoDependency{}
+	return nil
+}
+
+func todoDependencyAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *TodoDependency) error {
+	*o = TodoDependency{}
+	return nil
+}
+
+func todoDependencyBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TodoDependency) error {
+	*o = TodoDependency{}
+	return nil
+}
+
+func todoDependencyAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *TodoDependency) error {
+	*o = TodoDependency{}
+	return nil
+}
+
+func todoDependencyBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TodoDependency) error {
+	*o = TodoDependency{}
+	return nil
+}
+
+func todoDependencyAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *TodoDependency) error {
+	*o = TodoDependency{}
+	return nil
+}
+
+func todoDependencyBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TodoDependency) error {
+	*o = TodoDependency{}
+	return nil
+}
+
+func todoDependencyAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *TodoDependency) error {
+	*o = TodoDependency{}
+	return nil
+}
+
+func testTodoDependenciesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &TodoDependency{}
+	o := &TodoDependency{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, todoDependencyDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize TodoDependency object: %s", err)
+	}
+
+	AddTodoDependencyHook(boil.BeforeInsertHook, todoDependencyBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	todoDependencyBeforeInsertHooks = []TodoDependencyHook{}
+
+	AddTodoDependencyHook(boil.AfterInsertHook, todoDependencyAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	todoDependencyAfterInsertHooks = []TodoDependencyHook{}
+
+	AddTodoDependencyHook(boil.AfterSelectHook, todoDependencyAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	todoDependencyAfterSelectHooks = []TodoDependencyHook{}
+
+	AddTodoDependencyHook(boil.BeforeUpdateHook, todoDependencyBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	todoDependencyBeforeUpdateHooks = []TodoDependencyHook{}
+
+	AddTodoDependencyHook(boil.AfterUpdateHook, todoDependencyAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	todoDependencyAfterUpdateHooks = []TodoDependencyHook{}
+
+	AddTodoDependencyHook(boil.BeforeDeleteHook, todoDependencyBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	todoDependencyBeforeDeleteHooks = []TodoDependencyHook{}
+
+	AddTodoDependencyHook(boil.AfterDeleteHook, todoDependencyAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	todoDependencyAfterDeleteHooks = []TodoDependencyHook{}
+
+	AddTodoDependencyHook(boil.BeforeUpsertHook, todoDependencyBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	todoDependencyBeforeUpsertHooks = []TodoDependencyHook{}
+
+	AddTodoDependencyHook(boil.AfterUpsertHook, todoDependencyAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	todoDependencyAfterUpsertHooks = []TodoDependencyHook{}
+}
+
+func testTodoDependenciesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TodoDependency{}
+	if err = randomize.Struct(seed, o, todoDependencyDBTypes, true, todoDependencyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TodoDependencies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTodoDependenciesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TodoDependency{}
+	if err = randomize.Struct(seed, o, todoDependencyDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(strmangle.SetMerge(todoDependencyPrimaryKeyColumns, todoDependencyColumnsWithoutDefault)...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TodoDependencies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTodoDependencyToOneTodoUsingBlockedBy(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local TodoDependency
+	var foreign Todo
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, todoDependencyDBTypes, false, todoDependencyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, todoDBTypes, false, todoColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Todo struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.BlockedByID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.BlockedBy().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddTodoHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Todo) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := TodoDependencySlice{&local}
+	if err = local.L.LoadBlockedBy(ctx, tx, false, (*[]*TodoDependency)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.BlockedBy == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.BlockedBy = nil
+	if err = local.L.LoadBlockedBy(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.BlockedBy == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testTodoDependencyToOneTodoUsingTodo(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local TodoDependency
+	var foreign Todo
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, todoDependencyDBTypes, false, todoDependencyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, todoDBTypes, false, todoColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Todo struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.TodoID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Todo().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddTodoHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Todo) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := TodoDependencySlice{&local}
+	if err = local.L.LoadTodo(ctx, tx, false, (*[]*TodoDependency)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Todo == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Todo = nil
+	if err = local.L.LoadTodo(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Todo == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testTodoDependencyToOneSetOpTodoUsingBlockedBy(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TodoDependency
+	var b, c Todo
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, todoDependencyDBTypes, false, strmangle.SetComplement(todoDependencyPrimaryKeyColumns, todoDependencyColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, todoDBTypes, false, strmangle.SetComplement(todoPrimaryKeyColumns, todoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, todoDBTypes, false, strmangle.SetComplement(todoPrimaryKeyColumns, todoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Todo{&b, &c} {
+		err = a.SetBlockedBy(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.BlockedBy != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.BlockedByTodoDependencies[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.BlockedByID != x.ID {
+			t.Error("foreign key was wrong value", a.BlockedByID)
+		}
+
+		if exists, err := TodoDependencyExists(ctx, tx, a.TodoID, a.BlockedByID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testTodoDependencyToOneSetOpTodoUsingTodo(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TodoDependency
+	var b, c Todo
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, todoDependencyDBTypes, false, strmangle.SetComplement(todoDependencyPrimaryKeyColumns, todoDependencyColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, todoDBTypes, false, strmangle.SetComplement(todoPrimaryKeyColumns, todoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, todoDBTypes, false, strmangle.SetComplement(todoPrimaryKeyColumns, todoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Todo{&b, &c} {
+		err = a.SetTodo(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Todo != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.TodoDependencies[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.TodoID != x.ID {
+			t.Error("foreign key was wrong value", a.TodoID)
+		}
+
+		if exists, err := TodoDependencyExists(ctx, tx, a.TodoID, a.BlockedByID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testTodoDependenciesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TodoDependency{}
+	if err = randomize.Struct(seed, o, todoDependencyDBTypes, true, todoDependencyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTodoDependenciesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TodoDependency{}
+	if err = randomize.Struct(seed, o, todoDependencyDBTypes, true, todoDependencyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TodoDependencySlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTodoDependenciesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TodoDependency{}
+	if err = randomize.Struct(seed, o, todoDependencyDBTypes, true, todoDependencyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TodoDependencies().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	todoDependencyDBTypes = map[string]string{`TodoID`: `bigint`, `BlockedByID`: `bigint`, `CreatedAt`: `timestamp`}
+	_                     = bytes.MinRead
+)
+
+func testTodoDependenciesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(todoDependencyPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(todoDependencyAllColumns) == len(todoDependencyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TodoDependency{}
+	if err = randomize.Struct(seed, o, todoDependencyDBTypes, true, todoDependencyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TodoDependencies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, todoDependencyDBTypes, true, todoDependencyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testTodoDependenciesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(todoDependencyAllColumns) == len(todoDependencyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TodoDependency{}
+	if err = randomize.Struct(seed, o, todoDependencyDBTypes, true, todoDependencyColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TodoDependencies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, todoDependencyDBTypes, true, todoDependencyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(todoDependencyAllColumns, todoDependencyPrimaryKeyColumns) {
+		fields = todoDependencyAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			todoDependencyAllColumns,
+			todoDependencyPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := TodoDependencySlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testTodoDependenciesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(todoDependencyAllColumns) == len(todoDependencyPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+	if len(mySQLTodoDependencyUniqueColumns) == 0 {
+		t.Skip("Skipping table with no unique columns to conflict on")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := TodoDependency{}
+	if err = randomize.Struct(seed, &o, todoDependencyDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TodoDependency: %s", err)
+	}
+
+	count, err := TodoDependencies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, todoDependencyDBTypes, false, todoDependencyPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TodoDependency struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TodoDependency: %s", err)
+	}
+
+	count, err = TodoDependencies().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -29,6 +29,8 @@ type Todo struct {
 	Description null.String `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
 	DueDate     null.Time   `boil:"due_date" json:"due_date,omitempty" toml:"due_date" yaml:"due_date,omitempty"`
 	Status      string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Position    string      `boil:"position" json:"position" toml:"position" yaml:"position"`
+	CreatedBy   null.String `boil:"created_by" json:"created_by,omitempty" toml:"created_by" yaml:"created_by,omitempty"`
 	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt   time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	DeletedAt   null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
@@ -43,6 +45,8 @@ var TodoColumns = struct {
 	Description string
 	DueDate     string
 	Status      string
+	Position    string
+	CreatedBy   string
 	CreatedAt   string
 	UpdatedAt   string
 	DeletedAt   string
@@ -52,6 +56,8 @@ var TodoColumns = struct {
 	Description: "description",
 	DueDate:     "due_date",
 	Status:      "status",
+	Position:    "position",
+	CreatedBy:   "created_by",
 	CreatedAt:   "created_at",
 	UpdatedAt:   "updated_at",
 	DeletedAt:   "deleted_at",
@@ -63,6 +69,8 @@ var TodoTableColumns = struct {
 	Description string
 	DueDate     string
 	Status      string
+	Position    string
+	CreatedBy   string
 	CreatedAt   string
 	UpdatedAt   string
 	DeletedAt   string
@@ -72,6 +80,8 @@ var TodoTableColumns = struct {
 	Description: "todos.description",
 	DueDate:     "todos.due_date",
 	Status:      "todos.status",
+	Position:    "todos.position",
+	CreatedBy:   "todos.created_by",
 	CreatedAt:   "todos.created_at",
 	UpdatedAt:   "todos.updated_at",
 	DeletedAt:   "todos.deleted_at",
@@ -79,54 +89,6 @@ var TodoTableColumns = struct {
 
 // Generated where
 
-type whereHelperint64 struct{ field string }
-
-func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod  { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelpernull_String struct{ field string }
 
 func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
@@ -171,57 +133,14 @@ func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
 func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
 func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
-type whereHelpernull_Time struct{ field string }
-
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var TodoWhere = struct {
 	ID          whereHelperint64
 	Title       whereHelperstring
 	Description whereHelpernull_String
 	DueDate     whereHelpernull_Time
 	Status      whereHelperstring
+	Position    whereHelperstring
+	CreatedBy   whereHelpernull_String
 	CreatedAt   whereHelpertime_Time
 	UpdatedAt   whereHelpertime_Time
 	DeletedAt   whereHelpernull_Time
@@ -231,6 +150,8 @@ var TodoWhere = struct {
 	Description: whereHelpernull_String{field: "`todos`.`description`"},
 	DueDate:     whereHelpernull_Time{field: "`todos`.`due_date`"},
 	Status:      whereHelperstring{field: "`todos`.`status`"},
+	Position:    whereHelperstring{field: "`todos`.`position`"},
+	CreatedBy:   whereHelpernull_String{field: "`todos`.`created_by`"},
 	CreatedAt:   whereHelpertime_Time{field: "`todos`.`created_at`"},
 	UpdatedAt:   whereHelpertime_Time{field: "`todos`.`updated_at`"},
 	DeletedAt:   whereHelpernull_Time{field: "`todos`.`deleted_at`"},
@@ -238,10 +159,23 @@ var TodoWhere = struct {
 
 // TodoRels is where relationship names are stored.
 var TodoRels = struct {
-}{}
+	Attachments               string
+	Comments                  string
+	BlockedByTodoDependencies string
+	TodoDependencies          string
+}{
+	Attachments:               "Attachments",
+	Comments:                  "Comments",
+	BlockedByTodoDependencies: "BlockedByTodoDependencies",
+	TodoDependencies:          "TodoDependencies",
+}
 
 // todoR is where relationships are stored.
 type todoR struct {
+	Attachments               AttachmentSlice     `boil:"Attachments" json:"Attachments" toml:"Attachments" yaml:"Attachments"`
+	Comments                  CommentSlice        `boil:"Comments" json:"Comments" toml:"Comments" yaml:"Comments"`
+	BlockedByTodoDependencies TodoDependencySlice `boil:"BlockedByTodoDependencies" json:"BlockedByTodoDependencies" toml:"BlockedByTodoDependencies" yaml:"BlockedByTodoDependencies"`
+	TodoDependencies          TodoDependencySlice `boil:"TodoDependencies" json:"TodoDependencies" toml:"TodoDependencies" yaml:"TodoDependencies"`
 }
 
 // NewStruct creates a new relationship struct
@@ -249,12 +183,76 @@ func (*todoR) NewStruct() *todoR {
 	return &todoR{}
 }
 
+func (o *Todo) GetAttachments() AttachmentSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAttachments()
+}
+
+func (r *todoR) GetAttachments() AttachmentSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Attachments
+}
+
+func (o *Todo) GetComments() CommentSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetComments()
+}
+
+func (r *todoR) GetComments() CommentSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Comments
+}
+
+func (o *Todo) GetBlockedByTodoDependencies() TodoDependencySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBlockedByTodoDependencies()
+}
+
+func (r *todoR) GetBlockedByTodoDependencies() TodoDependencySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.BlockedByTodoDependencies
+}
+
+func (o *Todo) GetTodoDependencies() TodoDependencySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTodoDependencies()
+}
+
+func (r *todoR) GetTodoDependencies() TodoDependencySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.TodoDependencies
+}
+
 // todoL is where Load methods for each relationship are stored.
 type todoL struct{}
 
 var (
-	todoAllColumns            = []string{"id", "title", "description", "due_date", "status", "created_at", "updated_at", "deleted_at"}
-	todoColumnsWithoutDefault = []string{"title", "description", "due_date", "deleted_at"}
+	todoAllColumns            = []string{"id", "title", "description", "due_date", "status", "position", "created_by", "created_at", "updated_at", "deleted_at"}
+	todoColumnsWithoutDefault = []string{"title", "description", "due_date", "position", "created_by", "deleted_at"}
 	todoColumnsWithDefault    = []string{"id", "status", "created_at", "updated_at"}
 	todoPrimaryKeyColumns     = []string{"id"}
 	todoGeneratedColumns      = []string{}
@@ -565,6 +563,726 @@ func (q todoQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// Attachments retrieves all the attachment's Attachments with an executor.
+func (o *Todo) Attachments(mods ...qm.QueryMod) attachmentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`attachments`.`todo_id`=?", o.ID),
+	)
+
+	return Attachments(queryMods...)
+}
+
+// Comments retrieves all the comment's Comments with an executor.
+func (o *Todo) Comments(mods ...qm.QueryMod) commentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`comments`.`todo_id`=?", o.ID),
+	)
+
+	return Comments(queryMods...)
+}
+
+// BlockedByTodoDependencies retrieves all the todo_dependency's TodoDependencies with an executor via blocked_by_id column.
+func (o *Todo) BlockedByTodoDependencies(mods ...qm.QueryMod) todoDependencyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`todo_dependencies`.`blocked_by_id`=?", o.ID),
+	)
+
+	return TodoDependencies(queryMods...)
+}
+
+// TodoDependencies retrieves all the todo_dependency's TodoDependencies with an executor.
+func (o *Todo) TodoDependencies(mods ...qm.QueryMod) todoDependencyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`todo_dependencies`.`todo_id`=?", o.ID),
+	)
+
+	return TodoDependencies(queryMods...)
+}
+
+// LoadAttachments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadAttachments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`attachments`),
+		qm.WhereIn(`attachments.todo_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load attachments")
+	}
+
+	var resultSlice []*Attachment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice attachments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on attachments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for attachments")
+	}
+
+	if len(attachmentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Attachments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &attachmentR{}
+			}
+			foreign.R.Todo = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TodoID {
+				local.R.Attachments = append(local.R.Attachments, foreign)
+				if foreign.R == nil {
+					foreign.R = &attachmentR{}
+				}
+				foreign.R.Todo = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadComments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`comments`),
+		qm.WhereIn(`comments.todo_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load comments")
+	}
+
+	var resultSlice []*Comment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice comments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on comments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comments")
+	}
+
+	if len(commentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Comments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &commentR{}
+			}
+			foreign.R.Todo = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TodoID {
+				local.R.Comments = append(local.R.Comments, foreign)
+				if foreign.R == nil {
+					foreign.R = &commentR{}
+				}
+				foreign.R.Todo = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBlockedByTodoDependencies allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadBlockedByTodoDependencies(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todo_dependencies`),
+		qm.WhereIn(`todo_dependencies.blocked_by_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load todo_dependencies")
+	}
+
+	var resultSlice []*TodoDependency
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todo_dependencies")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todo_dependencies")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todo_dependencies")
+	}
+
+	if len(todoDependencyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BlockedByTodoDependencies = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoDependencyR{}
+			}
+			foreign.R.BlockedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BlockedByID {
+				local.R.BlockedByTodoDependencies = append(local.R.BlockedByTodoDependencies, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoDependencyR{}
+				}
+				foreign.R.BlockedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTodoDependencies allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadTodoDependencies(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todo_dependencies`),
+		qm.WhereIn(`todo_dependencies.todo_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load todo_dependencies")
+	}
+
+	var resultSlice []*TodoDependency
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todo_dependencies")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todo_dependencies")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todo_dependencies")
+	}
+
+	if len(todoDependencyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TodoDependencies = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoDependencyR{}
+			}
+			foreign.R.Todo = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TodoID {
+				local.R.TodoDependencies = append(local.R.TodoDependencies, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoDependencyR{}
+				}
+				foreign.R.Todo = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddAttachments adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.Attachments.
+// Sets related.R.Todo appropriately.
+func (o *Todo) AddAttachments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Attachment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TodoID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `attachments` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"todo_id"}),
+				strmangle.WhereClause("`", "`", 0, attachmentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TodoID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			Attachments: related,
+		}
+	} else {
+		o.R.Attachments = append(o.R.Attachments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &attachmentR{
+				Todo: o,
+			}
+		} else {
+			rel.R.Todo = o
+		}
+	}
+	return nil
+}
+
+// AddComments adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.Comments.
+// Sets related.R.Todo appropriately.
+func (o *Todo) AddComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Comment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TodoID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `comments` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"todo_id"}),
+				strmangle.WhereClause("`", "`", 0, commentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TodoID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			Comments: related,
+		}
+	} else {
+		o.R.Comments = append(o.R.Comments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &commentR{
+				Todo: o,
+			}
+		} else {
+			rel.R.Todo = o
+		}
+	}
+	return nil
+}
+
+// AddBlockedByTodoDependencies adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.BlockedByTodoDependencies.
+// Sets related.R.BlockedBy appropriately.
+func (o *Todo) AddBlockedByTodoDependencies(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TodoDependency) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BlockedByID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `todo_dependencies` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"blocked_by_id"}),
+				strmangle.WhereClause("`", "`", 0, todoDependencyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.TodoID, rel.BlockedByID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BlockedByID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			BlockedByTodoDependencies: related,
+		}
+	} else {
+		o.R.BlockedByTodoDependencies = append(o.R.BlockedByTodoDependencies, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoDependencyR{
+				BlockedBy: o,
+			}
+		} else {
+			rel.R.BlockedBy = o
+		}
+	}
+	return nil
+}
+
+// AddTodoDependencies adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.TodoDependencies.
+// Sets related.R.Todo appropriately.
+func (o *Todo) AddTodoDependencies(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TodoDependency) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TodoID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `todo_dependencies` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"todo_id"}),
+				strmangle.WhereClause("`", "`", 0, todoDependencyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.TodoID, rel.BlockedByID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TodoID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			TodoDependencies: related,
+		}
+	} else {
+		o.R.TodoDependencies = append(o.R.TodoDependencies, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoDependencyR{
+				Todo: o,
+			}
+		} else {
+			rel.R.Todo = o
+		}
+	}
+	return nil
+}
+
 // Todos retrieves all the records using an executor.
 func Todos(mods ...qm.QueryMod) todoQuery {
 	mods = append(mods, qm.From("`todos`"))
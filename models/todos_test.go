@@ -494,6 +494,619 @@ func testTodosInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testTodoToManyAttachments(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Todo
+	var b, c Attachment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, todoDBTypes, true, todoColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Todo struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, attachmentDBTypes, false, attachmentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, attachmentDBTypes, false, attachmentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.TodoID = a.ID
+	c.TodoID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Attachments().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.TodoID == b.TodoID {
+			bFound = true
+		}
+		if v.TodoID == c.TodoID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := TodoSlice{&a}
+	if err = a.L.LoadAttachments(ctx, tx, false, (*[]*Todo)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Attachments); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Attachments = nil
+	if err = a.L.LoadAttachments(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Attachments); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testTodoToManyComments(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Todo
+	var b, c Comment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, todoDBTypes, true, todoColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Todo struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, commentDBTypes, false, commentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, commentDBTypes, false, commentColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.TodoID = a.ID
+	c.TodoID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Comments().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.TodoID == b.TodoID {
+			bFound = true
+		}
+		if v.TodoID == c.TodoID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := TodoSlice{&a}
+	if err = a.L.LoadComments(ctx, tx, false, (*[]*Todo)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Comments); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Comments = nil
+	if err = a.L.LoadComments(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Comments); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testTodoToManyBlockedByTodoDependencies(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Todo
+	var b, c TodoDependency
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, todoDBTypes, true, todoColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Todo struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, todoDependencyDBTypes, false, todoDependencyColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, todoDependencyDBTypes, false, todoDependencyColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.BlockedByID = a.ID
+	c.BlockedByID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.BlockedByTodoDependencies().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.BlockedByID == b.BlockedByID {
+			bFound = true
+		}
+		if v.BlockedByID == c.BlockedByID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := TodoSlice{&a}
+	if err = a.L.LoadBlockedByTodoDependencies(ctx, tx, false, (*[]*Todo)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.BlockedByTodoDependencies); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.BlockedByTodoDependencies = nil
+	if err = a.L.LoadBlockedByTodoDependencies(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.BlockedByTodoDependencies); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testTodoToManyTodoDependencies(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Todo
+	var b, c TodoDependency
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, todoDBTypes, true, todoColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Todo struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, todoDependencyDBTypes, false, todoDependencyColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, todoDependencyDBTypes, false, todoDependencyColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.TodoID = a.ID
+	c.TodoID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.TodoDependencies().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.TodoID == b.TodoID {
+			bFound = true
+		}
+		if v.TodoID == c.TodoID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := TodoSlice{&a}
+	if err = a.L.LoadTodoDependencies(ctx, tx, false, (*[]*Todo)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TodoDependencies); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.TodoDependencies = nil
+	if err = a.L.LoadTodoDependencies(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.TodoDependencies); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testTodoToManyAddOpAttachments(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Todo
+	var b, c, d, e Attachment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, todoDBTypes, false, strmangle.SetComplement(todoPrimaryKeyColumns, todoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Attachment{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, attachmentDBTypes, false, strmangle.SetComplement(attachmentPrimaryKeyColumns, attachmentColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Attachment{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddAttachments(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.TodoID {
+			t.Error("foreign key was wrong value", a.ID, first.TodoID)
+		}
+		if a.ID != second.TodoID {
+			t.Error("foreign key was wrong value", a.ID, second.TodoID)
+		}
+
+		if first.R.Todo != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Todo != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Attachments[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Attachments[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Attachments().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testTodoToManyAddOpComments(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Todo
+	var b, c, d, e Comment
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, todoDBTypes, false, strmangle.SetComplement(todoPrimaryKeyColumns, todoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Comment{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, commentDBTypes, false, strmangle.SetComplement(commentPrimaryKeyColumns, commentColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Comment{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddComments(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.TodoID {
+			t.Error("foreign key was wrong value", a.ID, first.TodoID)
+		}
+		if a.ID != second.TodoID {
+			t.Error("foreign key was wrong value", a.ID, second.TodoID)
+		}
+
+		if first.R.Todo != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Todo != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Comments[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Comments[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Comments().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testTodoToManyAddOpBlockedByTodoDependencies(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Todo
+	var b, c, d, e TodoDependency
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, todoDBTypes, false, strmangle.SetComplement(todoPrimaryKeyColumns, todoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TodoDependency{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, todoDependencyDBTypes, false, strmangle.SetComplement(todoDependencyPrimaryKeyColumns, todoDependencyColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*TodoDependency{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddBlockedByTodoDependencies(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.BlockedByID {
+			t.Error("foreign key was wrong value", a.ID, first.BlockedByID)
+		}
+		if a.ID != second.BlockedByID {
+			t.Error("foreign key was wrong value", a.ID, second.BlockedByID)
+		}
+
+		if first.R.BlockedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.BlockedBy != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.BlockedByTodoDependencies[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.BlockedByTodoDependencies[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.BlockedByTodoDependencies().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testTodoToManyAddOpTodoDependencies(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Todo
+	var b, c, d, e TodoDependency
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, todoDBTypes, false, strmangle.SetComplement(todoPrimaryKeyColumns, todoColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TodoDependency{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, todoDependencyDBTypes, false, strmangle.SetComplement(todoDependencyPrimaryKeyColumns, todoDependencyColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*TodoDependency{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddTodoDependencies(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.TodoID {
+			t.Error("foreign key was wrong value", a.ID, first.TodoID)
+		}
+		if a.ID != second.TodoID {
+			t.Error("foreign key was wrong value", a.ID, second.TodoID)
+		}
+
+		if first.R.Todo != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Todo != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.TodoDependencies[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.TodoDependencies[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.TodoDependencies().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
 func testTodosReload(t *testing.T) {
 	t.Parallel()
 
@@ -568,7 +1181,7 @@ func testTodosSelect(t *testing.T) {
 }
 
 var (
-	todoDBTypes = map[string]string{`ID`: `bigint`, `Title`: `varchar`, `Description`: `text`, `DueDate`: `timestamp`, `Status`: `enum('TODO_STATUS_UNSPECIFIED','TODO_STATUS_INCOMPLETE','TODO_STATUS_COMPLETED')`, `CreatedAt`: `timestamp`, `UpdatedAt`: `timestamp`, `DeletedAt`: `timestamp`}
+	todoDBTypes = map[string]string{`ID`: `bigint`, `Title`: `varchar`, `Description`: `text`, `DueDate`: `timestamp`, `Status`: `enum('TODO_STATUS_UNSPECIFIED','TODO_STATUS_INCOMPLETE','TODO_STATUS_COMPLETED','TODO_STATUS_IN_PROGRESS','TODO_STATUS_BLOCKED','TODO_STATUS_CANCELLED')`, `Position`: `varchar`, `CreatedBy`: `varchar`, `CreatedAt`: `timestamp`, `UpdatedAt`: `timestamp`, `DeletedAt`: `timestamp`}
 	_           = bytes.MinRead
 )
 
@@ -0,0 +1,16 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package pgmodels
+
+var TableNames = struct {
+	Attachments      string
+	Comments         string
+	TodoDependencies string
+	Todos            string
+}{
+	Attachments:      "attachments",
+	Comments:         "comments",
+	TodoDependencies: "todo_dependencies",
+	Todos:            "todos",
+}
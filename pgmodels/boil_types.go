@@ -0,0 +1,73 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package pgmodels
+
+import (
+	"strconv"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// M type is for providing columns and column values to UpdateAll.
+type M map[string]interface{}
+
+// ErrSyncFail occurs during insert when the record could not be retrieved in
+// order to populate default value information. This usually happens when LastInsertId
+// fails or there was a primary key configuration that was not resolvable.
+var ErrSyncFail = errors.New("pgmodels: failed to synchronize data after insert")
+
+type insertCache struct {
+	query        string
+	retQuery     string
+	valueMapping []uint64
+	retMapping   []uint64
+}
+
+type updateCache struct {
+	query        string
+	valueMapping []uint64
+}
+
+func makeCacheKey(cols boil.Columns, nzDefaults []string) string {
+	buf := strmangle.GetBuffer()
+
+	buf.WriteString(strconv.Itoa(cols.Kind))
+	for _, w := range cols.Cols {
+		buf.WriteString(w)
+	}
+
+	if len(nzDefaults) != 0 {
+		buf.WriteByte('.')
+	}
+	for _, nz := range nzDefaults {
+		buf.WriteString(nz)
+	}
+
+	str := buf.String()
+	strmangle.PutBuffer(buf)
+	return str
+}
+
+// Enum values for TodoStatus
+const (
+	TodoStatusTODO_STATUS_UNSPECIFIED string = "TODO_STATUS_UNSPECIFIED"
+	TodoStatusTODO_STATUS_INCOMPLETE  string = "TODO_STATUS_INCOMPLETE"
+	TodoStatusTODO_STATUS_COMPLETED   string = "TODO_STATUS_COMPLETED"
+	TodoStatusTODO_STATUS_IN_PROGRESS string = "TODO_STATUS_IN_PROGRESS"
+	TodoStatusTODO_STATUS_BLOCKED     string = "TODO_STATUS_BLOCKED"
+	TodoStatusTODO_STATUS_CANCELLED   string = "TODO_STATUS_CANCELLED"
+)
+
+func AllTodoStatus() []string {
+	return []string{
+		TodoStatusTODO_STATUS_UNSPECIFIED,
+		TodoStatusTODO_STATUS_INCOMPLETE,
+		TodoStatusTODO_STATUS_COMPLETED,
+		TodoStatusTODO_STATUS_IN_PROGRESS,
+		TodoStatusTODO_STATUS_BLOCKED,
+		TodoStatusTODO_STATUS_CANCELLED,
+	}
+}
@@ -0,0 +1,1862 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package pgmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Todo is an object representing the database table.
+type Todo struct {
+	ID          int64       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Title       string      `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Description null.String `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
+	DueDate     null.Time   `boil:"due_date" json:"due_date,omitempty" toml:"due_date" yaml:"due_date,omitempty"`
+	Status      string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Position    string      `boil:"position" json:"position" toml:"position" yaml:"position"`
+	CreatedBy   null.String `boil:"created_by" json:"created_by,omitempty" toml:"created_by" yaml:"created_by,omitempty"`
+	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt   null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *todoR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L todoL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TodoColumns = struct {
+	ID          string
+	Title       string
+	Description string
+	DueDate     string
+	Status      string
+	Position    string
+	CreatedBy   string
+	CreatedAt   string
+	UpdatedAt   string
+	DeletedAt   string
+}{
+	ID:          "id",
+	Title:       "title",
+	Description: "description",
+	DueDate:     "due_date",
+	Status:      "status",
+	Position:    "position",
+	CreatedBy:   "created_by",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+	DeletedAt:   "deleted_at",
+}
+
+var TodoTableColumns = struct {
+	ID          string
+	Title       string
+	Description string
+	DueDate     string
+	Status      string
+	Position    string
+	CreatedBy   string
+	CreatedAt   string
+	UpdatedAt   string
+	DeletedAt   string
+}{
+	ID:          "todos.id",
+	Title:       "todos.title",
+	Description: "todos.description",
+	DueDate:     "todos.due_date",
+	Status:      "todos.status",
+	Position:    "todos.position",
+	CreatedBy:   "todos.created_by",
+	CreatedAt:   "todos.created_at",
+	UpdatedAt:   "todos.updated_at",
+	DeletedAt:   "todos.deleted_at",
+}
+
+// Generated where
+
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" LIKE ?", x)
+}
+func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT LIKE ?", x)
+}
+func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" ILIKE ?", x)
+}
+func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT ILIKE ?", x)
+}
+func (w whereHelpernull_String) SIMILAR(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" SIMILAR TO ?", x)
+}
+func (w whereHelpernull_String) NSIMILAR(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT SIMILAR TO ?", x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var TodoWhere = struct {
+	ID          whereHelperint64
+	Title       whereHelperstring
+	Description whereHelpernull_String
+	DueDate     whereHelpernull_Time
+	Status      whereHelperstring
+	Position    whereHelperstring
+	CreatedBy   whereHelpernull_String
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+	DeletedAt   whereHelpernull_Time
+}{
+	ID:          whereHelperint64{field: "\"todos\".\"id\""},
+	Title:       whereHelperstring{field: "\"todos\".\"title\""},
+	Description: whereHelpernull_String{field: "\"todos\".\"description\""},
+	DueDate:     whereHelpernull_Time{field: "\"todos\".\"due_date\""},
+	Status:      whereHelperstring{field: "\"todos\".\"status\""},
+	Position:    whereHelperstring{field: "\"todos\".\"position\""},
+	CreatedBy:   whereHelpernull_String{field: "\"todos\".\"created_by\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"todos\".\"created_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"todos\".\"updated_at\""},
+	DeletedAt:   whereHelpernull_Time{field: "\"todos\".\"deleted_at\""},
+}
+
+// TodoRels is where relationship names are stored.
+var TodoRels = struct {
+	Attachments               string
+	Comments                  string
+	BlockedByTodoDependencies string
+	TodoDependencies          string
+}{
+	Attachments:               "Attachments",
+	Comments:                  "Comments",
+	BlockedByTodoDependencies: "BlockedByTodoDependencies",
+	TodoDependencies:          "TodoDependencies",
+}
+
+// todoR is where relationships are stored.
+type todoR struct {
+	Attachments               AttachmentSlice     `boil:"Attachments" json:"Attachments" toml:"Attachments" yaml:"Attachments"`
+	Comments                  CommentSlice        `boil:"Comments" json:"Comments" toml:"Comments" yaml:"Comments"`
+	BlockedByTodoDependencies TodoDependencySlice `boil:"BlockedByTodoDependencies" json:"BlockedByTodoDependencies" toml:"BlockedByTodoDependencies" yaml:"BlockedByTodoDependencies"`
+	TodoDependencies          TodoDependencySlice `boil:"TodoDependencies" json:"TodoDependencies" toml:"TodoDependencies" yaml:"TodoDependencies"`
+}
+
+// NewStruct creates a new relationship struct
+func (*todoR) NewStruct() *todoR {
+	return &todoR{}
+}
+
+func (o *Todo) GetAttachments() AttachmentSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAttachments()
+}
+
+func (r *todoR) GetAttachments() AttachmentSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Attachments
+}
+
+func (o *Todo) GetComments() CommentSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetComments()
+}
+
+func (r *todoR) GetComments() CommentSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Comments
+}
+
+func (o *Todo) GetBlockedByTodoDependencies() TodoDependencySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBlockedByTodoDependencies()
+}
+
+func (r *todoR) GetBlockedByTodoDependencies() TodoDependencySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.BlockedByTodoDependencies
+}
+
+func (o *Todo) GetTodoDependencies() TodoDependencySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTodoDependencies()
+}
+
+func (r *todoR) GetTodoDependencies() TodoDependencySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.TodoDependencies
+}
+
+// todoL is where Load methods for each relationship are stored.
+type todoL struct{}
+
+var (
+	todoAllColumns            = []string{"id", "title", "description", "due_date", "status", "position", "created_by", "created_at", "updated_at", "deleted_at"}
+	todoColumnsWithoutDefault = []string{"title"}
+	todoColumnsWithDefault    = []string{"id", "description", "due_date", "status", "position", "created_by", "created_at", "updated_at", "deleted_at"}
+	todoPrimaryKeyColumns     = []string{"id"}
+	todoGeneratedColumns      = []string{}
+)
+
+type (
+	// TodoSlice is an alias for a slice of pointers to Todo.
+	// This should almost always be used instead of []Todo.
+	TodoSlice []*Todo
+	// TodoHook is the signature for custom Todo hook methods
+	TodoHook func(context.Context, boil.ContextExecutor, *Todo) error
+
+	todoQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	todoType                 = reflect.TypeOf(&Todo{})
+	todoMapping              = queries.MakeStructMapping(todoType)
+	todoPrimaryKeyMapping, _ = queries.BindMapping(todoType, todoMapping, todoPrimaryKeyColumns)
+	todoInsertCacheMut       sync.RWMutex
+	todoInsertCache          = make(map[string]insertCache)
+	todoUpdateCacheMut       sync.RWMutex
+	todoUpdateCache          = make(map[string]updateCache)
+	todoUpsertCacheMut       sync.RWMutex
+	todoUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var todoAfterSelectMu sync.Mutex
+var todoAfterSelectHooks []TodoHook
+
+var todoBeforeInsertMu sync.Mutex
+var todoBeforeInsertHooks []TodoHook
+var todoAfterInsertMu sync.Mutex
+var todoAfterInsertHooks []TodoHook
+
+var todoBeforeUpdateMu sync.Mutex
+var todoBeforeUpdateHooks []TodoHook
+var todoAfterUpdateMu sync.Mutex
+var todoAfterUpdateHooks []TodoHook
+
+var todoBeforeDeleteMu sync.Mutex
+var todoBeforeDeleteHooks []TodoHook
+var todoAfterDeleteMu sync.Mutex
+var todoAfterDeleteHooks []TodoHook
+
+var todoBeforeUpsertMu sync.Mutex
+var todoBeforeUpsertHooks []TodoHook
+var todoAfterUpsertMu sync.Mutex
+var todoAfterUpsertHooks []TodoHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Todo) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Todo) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Todo) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Todo) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Todo) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Todo) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Todo) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Todo) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Todo) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTodoHook registers your hook function for all future operations.
+func AddTodoHook(hookPoint boil.HookPoint, todoHook TodoHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		todoAfterSelectMu.Lock()
+		todoAfterSelectHooks = append(todoAfterSelectHooks, todoHook)
+		todoAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		todoBeforeInsertMu.Lock()
+		todoBeforeInsertHooks = append(todoBeforeInsertHooks, todoHook)
+		todoBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		todoAfterInsertMu.Lock()
+		todoAfterInsertHooks = append(todoAfterInsertHooks, todoHook)
+		todoAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		todoBeforeUpdateMu.Lock()
+		todoBeforeUpdateHooks = append(todoBeforeUpdateHooks, todoHook)
+		todoBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		todoAfterUpdateMu.Lock()
+		todoAfterUpdateHooks = append(todoAfterUpdateHooks, todoHook)
+		todoAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		todoBeforeDeleteMu.Lock()
+		todoBeforeDeleteHooks = append(todoBeforeDeleteHooks, todoHook)
+		todoBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		todoAfterDeleteMu.Lock()
+		todoAfterDeleteHooks = append(todoAfterDeleteHooks, todoHook)
+		todoAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		todoBeforeUpsertMu.Lock()
+		todoBeforeUpsertHooks = append(todoBeforeUpsertHooks, todoHook)
+		todoBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		todoAfterUpsertMu.Lock()
+		todoAfterUpsertHooks = append(todoAfterUpsertHooks, todoHook)
+		todoAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single todo record from the query.
+func (q todoQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Todo, error) {
+	o := &Todo{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "pgmodels: failed to execute a one query for todos")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Todo records from the query.
+func (q todoQuery) All(ctx context.Context, exec boil.ContextExecutor) (TodoSlice, error) {
+	var o []*Todo
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "pgmodels: failed to assign all query results to Todo slice")
+	}
+
+	if len(todoAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Todo records in the query.
+func (q todoQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "pgmodels: failed to count todos rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q todoQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "pgmodels: failed to check if todos exists")
+	}
+
+	return count > 0, nil
+}
+
+// Attachments retrieves all the attachment's Attachments with an executor.
+func (o *Todo) Attachments(mods ...qm.QueryMod) attachmentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"attachments\".\"todo_id\"=?", o.ID),
+	)
+
+	return Attachments(queryMods...)
+}
+
+// Comments retrieves all the comment's Comments with an executor.
+func (o *Todo) Comments(mods ...qm.QueryMod) commentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"comments\".\"todo_id\"=?", o.ID),
+	)
+
+	return Comments(queryMods...)
+}
+
+// BlockedByTodoDependencies retrieves all the todo_dependency's TodoDependencies with an executor via blocked_by_id column.
+func (o *Todo) BlockedByTodoDependencies(mods ...qm.QueryMod) todoDependencyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"todo_dependencies\".\"blocked_by_id\"=?", o.ID),
+	)
+
+	return TodoDependencies(queryMods...)
+}
+
+// TodoDependencies retrieves all the todo_dependency's TodoDependencies with an executor.
+func (o *Todo) TodoDependencies(mods ...qm.QueryMod) todoDependencyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"todo_dependencies\".\"todo_id\"=?", o.ID),
+	)
+
+	return TodoDependencies(queryMods...)
+}
+
+// LoadAttachments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadAttachments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`attachments`),
+		qm.WhereIn(`attachments.todo_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load attachments")
+	}
+
+	var resultSlice []*Attachment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice attachments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on attachments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for attachments")
+	}
+
+	if len(attachmentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Attachments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &attachmentR{}
+			}
+			foreign.R.Todo = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TodoID {
+				local.R.Attachments = append(local.R.Attachments, foreign)
+				if foreign.R == nil {
+					foreign.R = &attachmentR{}
+				}
+				foreign.R.Todo = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadComments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`comments`),
+		qm.WhereIn(`comments.todo_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load comments")
+	}
+
+	var resultSlice []*Comment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice comments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on comments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comments")
+	}
+
+	if len(commentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Comments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &commentR{}
+			}
+			foreign.R.Todo = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TodoID {
+				local.R.Comments = append(local.R.Comments, foreign)
+				if foreign.R == nil {
+					foreign.R = &commentR{}
+				}
+				foreign.R.Todo = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBlockedByTodoDependencies allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadBlockedByTodoDependencies(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todo_dependencies`),
+		qm.WhereIn(`todo_dependencies.blocked_by_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load todo_dependencies")
+	}
+
+	var resultSlice []*TodoDependency
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todo_dependencies")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todo_dependencies")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todo_dependencies")
+	}
+
+	if len(todoDependencyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BlockedByTodoDependencies = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoDependencyR{}
+			}
+			foreign.R.BlockedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BlockedByID {
+				local.R.BlockedByTodoDependencies = append(local.R.BlockedByTodoDependencies, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoDependencyR{}
+				}
+				foreign.R.BlockedBy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTodoDependencies allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadTodoDependencies(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todo_dependencies`),
+		qm.WhereIn(`todo_dependencies.todo_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load todo_dependencies")
+	}
+
+	var resultSlice []*TodoDependency
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todo_dependencies")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todo_dependencies")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todo_dependencies")
+	}
+
+	if len(todoDependencyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TodoDependencies = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoDependencyR{}
+			}
+			foreign.R.Todo = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TodoID {
+				local.R.TodoDependencies = append(local.R.TodoDependencies, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoDependencyR{}
+				}
+				foreign.R.Todo = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddAttachments adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.Attachments.
+// Sets related.R.Todo appropriately.
+func (o *Todo) AddAttachments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Attachment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TodoID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"attachments\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"todo_id"}),
+				strmangle.WhereClause("\"", "\"", 2, attachmentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TodoID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			Attachments: related,
+		}
+	} else {
+		o.R.Attachments = append(o.R.Attachments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &attachmentR{
+				Todo: o,
+			}
+		} else {
+			rel.R.Todo = o
+		}
+	}
+	return nil
+}
+
+// AddComments adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.Comments.
+// Sets related.R.Todo appropriately.
+func (o *Todo) AddComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Comment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TodoID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"comments\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"todo_id"}),
+				strmangle.WhereClause("\"", "\"", 2, commentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TodoID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			Comments: related,
+		}
+	} else {
+		o.R.Comments = append(o.R.Comments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &commentR{
+				Todo: o,
+			}
+		} else {
+			rel.R.Todo = o
+		}
+	}
+	return nil
+}
+
+// AddBlockedByTodoDependencies adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.BlockedByTodoDependencies.
+// Sets related.R.BlockedBy appropriately.
+func (o *Todo) AddBlockedByTodoDependencies(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TodoDependency) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BlockedByID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"todo_dependencies\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"blocked_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, todoDependencyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.TodoID, rel.BlockedByID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BlockedByID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			BlockedByTodoDependencies: related,
+		}
+	} else {
+		o.R.BlockedByTodoDependencies = append(o.R.BlockedByTodoDependencies, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoDependencyR{
+				BlockedBy: o,
+			}
+		} else {
+			rel.R.BlockedBy = o
+		}
+	}
+	return nil
+}
+
+// AddTodoDependencies adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.TodoDependencies.
+// Sets related.R.Todo appropriately.
+func (o *Todo) AddTodoDependencies(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TodoDependency) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TodoID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"todo_dependencies\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"todo_id"}),
+				strmangle.WhereClause("\"", "\"", 2, todoDependencyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.TodoID, rel.BlockedByID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TodoID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			TodoDependencies: related,
+		}
+	} else {
+		o.R.TodoDependencies = append(o.R.TodoDependencies, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoDependencyR{
+				Todo: o,
+			}
+		} else {
+			rel.R.Todo = o
+		}
+	}
+	return nil
+}
+
+// Todos retrieves all the records using an executor.
+func Todos(mods ...qm.QueryMod) todoQuery {
+	mods = append(mods, qm.From("\"todos\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"todos\".*"})
+	}
+
+	return todoQuery{q}
+}
+
+// FindTodo retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTodo(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*Todo, error) {
+	todoObj := &Todo{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"todos\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, todoObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "pgmodels: unable to select from todos")
+	}
+
+	if err = todoObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return todoObj, err
+	}
+
+	return todoObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Todo) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("pgmodels: no todos provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(todoColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	todoInsertCacheMut.RLock()
+	cache, cached := todoInsertCache[key]
+	todoInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			todoAllColumns,
+			todoColumnsWithDefault,
+			todoColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(todoType, todoMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(todoType, todoMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"todos\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"todos\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pgmodels: unable to insert into todos")
+	}
+
+	if !cached {
+		todoInsertCacheMut.Lock()
+		todoInsertCache[key] = cache
+		todoInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Todo.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Todo) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	todoUpdateCacheMut.RLock()
+	cache, cached := todoUpdateCache[key]
+	todoUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			todoAllColumns,
+			todoPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("pgmodels: unable to update todos, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"todos\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, todoPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(todoType, todoMapping, append(wl, todoPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "pgmodels: unable to update todos row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "pgmodels: failed to get rows affected by update for todos")
+	}
+
+	if !cached {
+		todoUpdateCacheMut.Lock()
+		todoUpdateCache[key] = cache
+		todoUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q todoQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "pgmodels: unable to update all for todos")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "pgmodels: unable to retrieve rows affected for todos")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TodoSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("pgmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"todos\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, todoPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "pgmodels: unable to update all in todo slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "pgmodels: unable to retrieve rows affected all in update all todo")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Todo) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("pgmodels: no todos provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(todoColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	todoUpsertCacheMut.RLock()
+	cache, cached := todoUpsertCache[key]
+	todoUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			todoAllColumns,
+			todoColumnsWithDefault,
+			todoColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			todoAllColumns,
+			todoPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("pgmodels: unable to upsert todos, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(todoAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(todoPrimaryKeyColumns) == 0 {
+				return errors.New("pgmodels: unable to upsert todos, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(todoPrimaryKeyColumns))
+			copy(conflict, todoPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"todos\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(todoType, todoMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(todoType, todoMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "pgmodels: unable to upsert todos")
+	}
+
+	if !cached {
+		todoUpsertCacheMut.Lock()
+		todoUpsertCache[key] = cache
+		todoUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Todo record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Todo) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("pgmodels: no Todo provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), todoPrimaryKeyMapping)
+	sql := "DELETE FROM \"todos\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "pgmodels: unable to delete from todos")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "pgmodels: failed to get rows affected by delete for todos")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q todoQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("pgmodels: no todoQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "pgmodels: unable to delete all from todos")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "pgmodels: failed to get rows affected by deleteall for todos")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TodoSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(todoBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"todos\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, todoPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "pgmodels: unable to delete all from todo slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "pgmodels: failed to get rows affected by deleteall for todos")
+	}
+
+	if len(todoAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Todo) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTodo(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TodoSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TodoSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"todos\".* FROM \"todos\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, todoPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "pgmodels: unable to reload all in TodoSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TodoExists checks if the Todo row exists.
+func TodoExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"todos\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "pgmodels: unable to check if todos exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Todo row exists.
+func (o *Todo) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TodoExists(ctx, exec, o.ID)
+}
@@ -1,12 +1,39 @@
+#!/bin/sh
+# 使い方: health_check.sh [アドレス]
+# アドレスはポート (8080)、ホスト:ポート (todo:8080)、または URL (https://todo:8443) で指定します。
+# 省略すると .env と環境変数の SERVER_PORT から localhost のポートを決めます。
+# 設定ファイルでポートを変えている場合はアドレスを渡してください。
+
 # Load environment variables from .env file
 if [ -f .env ]; then
     export $(cat .env | sed 's/#.*//g' | xargs)
 fi
 
-if ! nc -z localhost $PORT; then
-    echo "Service is not running on port $PORT"
+ADDR="${1:-${SERVER_PORT:-8080}}"
+
+SCHEME=http
+WGET_OPTS=
+if [ -n "$TLS_CERT_FILE" ]; then
+    SCHEME=https
+fi
+case "$ADDR" in
+    http://*|https://*) BASE_URL="$ADDR" ;;
+    *:*) BASE_URL="$SCHEME://$ADDR" ;;
+    *) BASE_URL="$SCHEME://localhost:$ADDR" ;;
+esac
+case "$BASE_URL" in
+    https://*)
+        # localhost は証明書の名前と一致しないことがあるため検証しない
+        # (相互 TLS でも /healthz はクライアント証明書なしで応答します)
+        WGET_OPTS=--no-check-certificate
+        ;;
+esac
+
+# /healthz はプロセスが HTTP に応答できるかだけを確認します (liveness)
+if ! wget -q $WGET_OPTS -O /dev/null "${BASE_URL%/}/healthz"; then
+    echo "Service is not healthy at $BASE_URL"
     exit 1
 fi
 
-echo "Service is running on port $PORT"
+echo "Service is healthy at $BASE_URL"
 exit 0